@@ -3,6 +3,7 @@ package examples
 import (
 	"os"
 
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/probe"
 	"github.com/litmuschaos/litmus-go-sdk/pkg/logger"
 	"github.com/litmuschaos/litmus-go-sdk/pkg/sdk"
 	"github.com/litmuschaos/litmus-go-sdk/pkg/types"
@@ -142,16 +143,12 @@ func CompleteSDKExample() {
 	})
 	
 	// Get probe YAML
-	yamlRequest := models.GetProbeYAMLRequest{
-		ProbeName: "my-probe-name",
-		Mode: "SOT",
-	}
-	probeYAML, err := client.Probes().GetProbeYAML("project-id", "probe-id", yamlRequest)
+	probeSpec, err := client.Probes().GetProbeYAML("project-id", "my-probe-name", probe.ProbeModeSOT)
 	if err != nil {
 		logger.Fatalf("Failed to get probe YAML: %v", err)
 	}
 	logger.InfoWithValues("Probe YAML", map[string]interface{}{
-		"yaml": probeYAML,
+		"yaml": probeSpec.Raw,
 	})
 
 }
@@ -0,0 +1,62 @@
+package chaoshub
+
+import (
+	"fmt"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/types"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/utils"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+// ListChaosHub lists the ChaosHubs connected to the specified project
+func ListChaosHub(pid string, cred types.Credentials) (ListChaosHubData, error) {
+	if pid == "" {
+		return ListChaosHubData{}, fmt.Errorf("project ID cannot be empty")
+	}
+
+	return utils.SendGraphQLRequest[ListChaosHubData](
+		fmt.Sprintf("%s%s", cred.Endpoint, utils.GQLAPIPath),
+		cred.Token,
+		ListChaosHubQuery,
+		struct {
+			ProjectID string `json:"projectID"`
+		}{
+			ProjectID: pid,
+		},
+		"Error in Getting ChaosHub List",
+	)
+}
+
+// AddChaosHub connects a git ChaosHub to the specified project
+func AddChaosHub(pid string, request models.CreateChaosHubRequest, cred types.Credentials) (AddChaosHubData, error) {
+	return utils.SendGraphQLRequest[AddChaosHubData](
+		fmt.Sprintf("%s%s", cred.Endpoint, utils.GQLAPIPath),
+		cred.Token,
+		AddChaosHubQuery,
+		struct {
+			ProjectID string                       `json:"projectID"`
+			Request   models.CreateChaosHubRequest `json:"request"`
+		}{
+			ProjectID: pid,
+			Request:   request,
+		},
+		"Error in Adding ChaosHub",
+	)
+}
+
+// AddRemoteChaosHub connects a ChaosHub downloaded from a remote archive to the specified project
+func AddRemoteChaosHub(pid string, request models.CreateRemoteChaosHub, cred types.Credentials) (AddRemoteChaosHubData, error) {
+	return utils.SendGraphQLRequest[AddRemoteChaosHubData](
+		fmt.Sprintf("%s%s", cred.Endpoint, utils.GQLAPIPath),
+		cred.Token,
+		AddRemoteChaosHubQuery,
+		struct {
+			ProjectID string                      `json:"projectID"`
+			Request   models.CreateRemoteChaosHub `json:"request"`
+		}{
+			ProjectID: pid,
+			Request:   request,
+		},
+		"Error in Adding Remote ChaosHub",
+	)
+}
@@ -0,0 +1,50 @@
+package chaoshub
+
+const (
+	ListChaosHubQuery = `query listChaosHub($projectID: ID!, $request: ListChaosHubRequest) {
+					listChaosHub(projectID: $projectID, request: $request) {
+						id
+						name
+						description
+						tags
+						repoURL
+						repoBranch
+						remoteHub
+						hubType
+						isPrivate
+						authType
+						token
+						userName
+						password
+						sshPrivateKey
+						sshPublicKey
+						isAvailable
+						isDefault
+						lastSyncedAt
+						createdAt
+						updatedAt
+					}
+				}`
+
+	AddChaosHubQuery = `mutation addChaosHub($projectID: ID!, $request: CreateChaosHubRequest!) {
+					addChaosHub(projectID: $projectID, request: $request) {
+						id
+						name
+						repoURL
+						repoBranch
+						hubType
+						isDefault
+					}
+				}`
+
+	AddRemoteChaosHubQuery = `mutation addRemoteChaosHub($projectID: ID!, $request: CreateRemoteChaosHub!) {
+					addRemoteChaosHub(projectID: $projectID, request: $request) {
+						id
+						name
+						repoURL
+						remoteHub
+						hubType
+						isDefault
+					}
+				}`
+)
@@ -0,0 +1,18 @@
+package chaoshub
+
+import model "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+
+// Type for ListChaosHub
+type ListChaosHubData struct {
+	ListChaosHub []*model.ChaosHubStatus `json:"listChaosHub"`
+}
+
+// Type for AddChaosHub
+type AddChaosHubData struct {
+	AddChaosHub model.ChaosHub `json:"addChaosHub"`
+}
+
+// Type for AddRemoteChaosHub
+type AddRemoteChaosHubData struct {
+	AddRemoteChaosHub model.ChaosHub `json:"addRemoteChaosHub"`
+}
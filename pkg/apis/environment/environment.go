@@ -75,4 +75,21 @@ func DeleteEnvironment(pid string, envid string, cred types.Credentials) (Delete
 		},
 		"Error in Deleting Chaos Environment",
 	)
-}
\ No newline at end of file
+}
+
+// UpdateEnvironment changes the name, description, type or tags of an environment
+func UpdateEnvironment(pid string, request models.UpdateEnvironmentRequest, cred types.Credentials) (UpdateEnvironmentData, error) {
+	return utils.SendGraphQLRequest[UpdateEnvironmentData](
+		fmt.Sprintf("%s%s", cred.Endpoint, utils.GQLAPIPath),
+		cred.Token,
+		UpdateEnvironmentQuery,
+		struct {
+			ProjectID string                          `json:"projectID"`
+			Request   models.UpdateEnvironmentRequest `json:"request"`
+		}{
+			ProjectID: pid,
+			Request:   request,
+		},
+		"Error in Updating Chaos Environment",
+	)
+}
@@ -34,6 +34,8 @@ const (
 						environments {
 							environmentID
 							name
+							description
+							tags
 							createdAt
 							updatedAt
 							createdBy{
@@ -54,4 +56,11 @@ const (
 					environmentID: $environmentID
 					)
 				}`
+
+	UpdateEnvironmentQuery = `mutation updateEnvironment($projectID: ID!, $request: UpdateEnvironmentRequest!) {
+					updateEnvironment(
+					projectID: $projectID
+					request: $request
+					)
+				}`
 )
@@ -20,4 +20,9 @@ type GetEnvironmentData struct {
 // Type for DeleteEnvironment
 type DeleteChaosEnvironmentData struct {
     DeleteEnvironment string `json:"deleteEnvironment"`
-}
\ No newline at end of file
+}
+
+// Type for UpdateEnvironment
+type UpdateEnvironmentData struct {
+    UpdateEnvironment string `json:"updateEnvironment"`
+}
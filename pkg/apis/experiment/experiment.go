@@ -16,6 +16,7 @@ limitations under the License.
 package experiment
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/litmuschaos/litmus-go-sdk/pkg/types"
@@ -150,6 +151,25 @@ func DeleteChaosExperiment(pid string, eid *string, cred types.Credentials) (Del
 	)
 }
 
+// DeleteChaosExperimentRun sends GraphQL API request for deleting a single run of a given Chaos Experiment.
+func DeleteChaosExperimentRun(pid string, eid string, runID string, cred types.Credentials) (DeleteChaosExperimentDetails, error) {
+	return utils.SendGraphQLRequest[DeleteChaosExperimentDetails](
+		fmt.Sprintf("%s%s", cred.Endpoint, utils.GQLAPIPath),
+		cred.Token,
+		DeleteExperimentQuery,
+		struct {
+			ProjectID       string  `json:"projectID"`
+			ExperimentID    *string `json:"experimentID"`
+			ExperimentRunID *string `json:"experimentRunID"`
+		}{
+			ProjectID:       pid,
+			ExperimentID:    &eid,
+			ExperimentRunID: &runID,
+		},
+		"Error in deleting Chaos Experiment run",
+	)
+}
+
 // GetExperimentRun sends GraphQL API request for getting a specific experiment run.
 func GetExperimentRun(pid string, runID string, cred types.Credentials) (ExperimentRunDetails, error) {
 	return utils.SendGraphQLRequest[ExperimentRunDetails](
@@ -166,3 +186,134 @@ func GetExperimentRun(pid string, runID string, cred types.Credentials) (Experim
 		"Error in fetching Chaos Experiment Run",
 	)
 }
+
+// GetExperiment sends GraphQL API request for fetching the details of a specific experiment.
+func GetExperiment(pid string, eid string, cred types.Credentials) (ExperimentStatusDetails, error) {
+	return utils.SendGraphQLRequest[ExperimentStatusDetails](
+		fmt.Sprintf("%s%s", cred.Endpoint, utils.GQLAPIPath),
+		cred.Token,
+		GetExperimentQuery,
+		struct {
+			ProjectID    string `json:"projectID"`
+			ExperimentID string `json:"experimentID"`
+		}{
+			ProjectID:    pid,
+			ExperimentID: eid,
+		},
+		"Error in fetching Chaos Experiment",
+	)
+}
+
+// GetExperimentRunManifests sends GraphQL API request for fetching experiment runs along with the manifest each run executed.
+func GetExperimentRunManifests(pid string, in model.ListExperimentRunRequest, cred types.Credentials) (ExperimentRunsList, error) {
+	return utils.SendGraphQLRequest[ExperimentRunsList](
+		fmt.Sprintf("%s%s", cred.Endpoint, utils.GQLAPIPath),
+		cred.Token,
+		ListExperimentRunManifestsQuery,
+		struct {
+			ProjectID string                         `json:"projectID"`
+			Request   model.ListExperimentRunRequest `json:"request"`
+		}{
+			ProjectID: pid,
+			Request:   in,
+		},
+		"Error in fetching Chaos Experiment run manifests",
+	)
+}
+
+// UpdateCronExperimentState sends GraphQL API request for enabling or disabling the schedule of a cron experiment.
+func UpdateCronExperimentState(pid string, eid string, disable bool, cred types.Credentials) (UpdateCronExperimentStateData, error) {
+	return utils.SendGraphQLRequest[UpdateCronExperimentStateData](
+		fmt.Sprintf("%s%s", cred.Endpoint, utils.GQLAPIPath),
+		cred.Token,
+		UpdateCronExperimentStateQuery,
+		struct {
+			ExperimentID string `json:"experimentID"`
+			Disable      bool   `json:"disable"`
+			ProjectID    string `json:"projectID"`
+		}{
+			ExperimentID: eid,
+			Disable:      disable,
+			ProjectID:    pid,
+		},
+		"Error in updating Chaos Experiment schedule",
+	)
+}
+
+// GetExperimentStats sends GraphQL API request for fetching experiment statistics of a project.
+func GetExperimentStats(pid string, cred types.Credentials) (ExperimentStats, error) {
+	return utils.SendGraphQLRequest[ExperimentStats](
+		fmt.Sprintf("%s%s", cred.Endpoint, utils.GQLAPIPath),
+		cred.Token,
+		GetExperimentStatsQuery,
+		struct {
+			ProjectID string `json:"projectID"`
+		}{
+			ProjectID: pid,
+		},
+		"Error in fetching Chaos Experiment stats",
+	)
+}
+
+// GetExperimentRunStats sends GraphQL API request for fetching experiment run statistics of a project.
+func GetExperimentRunStats(pid string, cred types.Credentials) (ExperimentRunStats, error) {
+	return utils.SendGraphQLRequest[ExperimentRunStats](
+		fmt.Sprintf("%s%s", cred.Endpoint, utils.GQLAPIPath),
+		cred.Token,
+		GetExperimentRunStatsQuery,
+		struct {
+			ProjectID string `json:"projectID"`
+		}{
+			ProjectID: pid,
+		},
+		"Error in fetching Chaos Experiment run stats",
+	)
+}
+
+// GetExperimentRunByNotifyID sends GraphQL API request for getting an experiment run, including its execution data, by the notify ID returned when it was started.
+func GetExperimentRunByNotifyID(pid string, notifyID string, cred types.Credentials) (ExperimentRunDetails, error) {
+	return utils.SendGraphQLRequest[ExperimentRunDetails](
+		fmt.Sprintf("%s%s", cred.Endpoint, utils.GQLAPIPath),
+		cred.Token,
+		GetExperimentRunByNotifyIDQuery,
+		struct {
+			ProjectID string `json:"projectID"`
+			NotifyID  string `json:"notifyID"`
+		}{
+			ProjectID: pid,
+			NotifyID:  notifyID,
+		},
+		"Error in fetching Chaos Experiment Run",
+	)
+}
+
+// StopExperimentRuns sends GraphQL API request for stopping the ongoing runs of an experiment, or a single run if runID is set.
+func StopExperimentRuns(pid string, eid string, runID *string, cred types.Credentials) (StopExperimentRunsData, error) {
+	return utils.SendGraphQLRequest[StopExperimentRunsData](
+		fmt.Sprintf("%s%s", cred.Endpoint, utils.GQLAPIPath),
+		cred.Token,
+		StopExperimentRunsQuery,
+		struct {
+			ProjectID       string  `json:"projectID"`
+			ExperimentID    string  `json:"experimentID"`
+			ExperimentRunID *string `json:"experimentRunID"`
+		}{
+			ProjectID:       pid,
+			ExperimentID:    eid,
+			ExperimentRunID: runID,
+		},
+		"Error in stopping Chaos Experiment runs",
+	)
+}
+
+// ParseExecutionData decodes the execution data recorded on an experiment run
+func ParseExecutionData(run model.ExperimentRun) (ExecutionData, error) {
+	var data ExecutionData
+	if run.ExecutionData == "" {
+		return data, nil
+	}
+	if err := json.Unmarshal([]byte(run.ExecutionData), &data); err != nil {
+		return data, fmt.Errorf("failed to parse execution data of run %s: %v", run.ExperimentRunID, err)
+	}
+	return data, nil
+}
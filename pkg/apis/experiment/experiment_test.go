@@ -1188,6 +1188,72 @@ func TestGetExperimentRun(t *testing.T) {
     }
 }
 
+func TestGetExperiment(t *testing.T) {
+    tests := []struct {
+        name         string
+        projectID    string
+        experimentID string
+        wantErr      bool
+        validateFn   func(*testing.T, *ExperimentStatusDetails)
+    }{
+        {
+            name:         "successful experiment fetch",
+            projectID:    projectID,
+            experimentID: experimentID,
+            wantErr:      false,
+            validateFn: func(t *testing.T, result *ExperimentStatusDetails) {
+                assert.NotNil(t, result.ExperimentDetails.ExperimentDetails, "Experiment details should not be nil")
+                details := result.ExperimentDetails.ExperimentDetails
+                assert.Equal(t, experimentID, details.ExperimentID, "Experiment ID should match")
+                assert.NotEmpty(t, details.ExperimentManifest, "Experiment manifest should not be empty")
+            },
+        },
+        {
+            name:         "experiment fetch with non-existent ID",
+            projectID:    projectID,
+            experimentID: "non-existent-experiment-id",
+            wantErr:      true,
+            validateFn:   nil,
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            client, err := setupTestClient()
+            assert.NoError(t, err, "Failed to create Litmus client")
+
+            result, err := GetExperiment(tt.projectID, tt.experimentID, client.credentials)
+
+            if tt.wantErr {
+                assert.Error(t, err)
+                return
+            }
+
+            assert.NoError(t, err)
+
+            if tt.validateFn != nil {
+                tt.validateFn(t, &result)
+            }
+        })
+    }
+}
+
+func TestGetExperimentStats(t *testing.T) {
+    client, err := setupTestClient()
+    assert.NoError(t, err, "Failed to create Litmus client")
+
+    stats, err := GetExperimentStats(projectID, client.credentials)
+    assert.NoError(t, err)
+    assert.GreaterOrEqual(t, stats.ExperimentStats.TotalExperiments, 1, "Seeded experiment should be counted")
+
+    runStats, err := GetExperimentRunStats(projectID, client.credentials)
+    assert.NoError(t, err)
+    assert.GreaterOrEqual(t, runStats.ExperimentRunStats.TotalExperimentRuns, runStats.ExperimentRunStats.TotalCompletedExperimentRuns)
+
+    _, err = GetExperimentStats("", client.credentials)
+    assert.Error(t, err, "Empty project ID should be rejected")
+}
+
 func ptr(s string) *string {
     return &s
 }
\ No newline at end of file
@@ -21,6 +21,7 @@ const (
                           infra {
                             name
                             infraID
+                            environmentID
                           }
                           createdBy {
                             username
@@ -49,6 +50,7 @@ const (
                           infra {
                           name
                           }
+                          createdAt
                           updatedAt
                           updatedBy{
                               username
@@ -92,4 +94,106 @@ const (
                         }
                       }
                     }`
+
+	GetExperimentQuery = `query getExperiment($projectID: ID!, $experimentID: String!) {
+                      getExperiment(projectID: $projectID, experimentID: $experimentID) {
+                        experimentDetails {
+                          experimentID
+                          experimentType
+                          experimentManifest
+                          cronSyntax
+                          name
+                          description
+                          tags
+                          createdAt
+                          updatedAt
+                          infra {
+                            name
+                            infraID
+                          }
+                          updatedBy {
+                            username
+                          }
+                        }
+                        averageResiliencyScore
+                      }
+                    }`
+
+	ListExperimentRunManifestsQuery = `query listExperimentRuns($projectID: ID!, $request: ListExperimentRunRequest!) {
+                      listExperimentRun(projectID: $projectID, request: $request) {
+                        totalNoOfExperimentRuns
+                        experimentRuns {
+                          experimentRunID
+                          experimentID
+                          experimentManifest
+                          createdAt
+                          updatedAt
+                          updatedBy {
+                            username
+                          }
+                        }
+                      }
+                    }`
+
+	UpdateCronExperimentStateQuery = `mutation updateCronExperimentState($experimentID: String!, $disable: Boolean!, $projectID: ID!) {
+                      updateCronExperimentState(
+                        experimentID: $experimentID
+                        disable: $disable
+                        projectID: $projectID
+                      )
+                    }`
+
+	GetExperimentStatsQuery = `query getExperimentStats($projectID: ID!) {
+                      getExperimentStats(projectID: $projectID) {
+                        totalExperiments
+                        totalExpCategorizedByResiliencyScore {
+                          id
+                          count
+                        }
+                      }
+                    }`
+
+	GetExperimentRunStatsQuery = `query getExperimentRunStats($projectID: ID!) {
+                      getExperimentRunStats(projectID: $projectID) {
+                        totalExperimentRuns
+                        totalCompletedExperimentRuns
+                        totalTerminatedExperimentRuns
+                        totalRunningExperimentRuns
+                        totalStoppedExperimentRuns
+                        totalErroredExperimentRuns
+                      }
+                    }`
+
+	GetExperimentRunByNotifyIDQuery = `query getExperimentRun($projectID: ID!, $notifyID: ID) {
+                      getExperimentRun(projectID: $projectID, notifyID: $notifyID) {
+                        projectID
+                        experimentRunID
+                        experimentID
+                        experimentName
+                        notifyID
+                        phase
+                        resiliencyScore
+                        faultsPassed
+                        faultsFailed
+                        faultsAwaited
+                        faultsStopped
+                        faultsNa
+                        totalFaults
+                        executionData
+                        createdAt
+                        updatedAt
+                        updatedBy {
+                          username
+                        }
+                      }
+                    }`
+
+	StopExperimentRunsQuery = `mutation stopExperimentRuns($projectID: ID!, $experimentID: String!, $experimentRunID: String, $notifyID: String) {
+                      stopExperimentRuns(
+                        projectID: $projectID
+                        experimentID: $experimentID
+                        experimentRunID: $experimentRunID
+                        notifyID: $notifyID
+                      )
+                    }`
 )
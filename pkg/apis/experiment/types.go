@@ -46,6 +46,82 @@ type DeleteChaosExperimentDetails struct {
 	IsDeleted bool `json:"deleteChaosExperiment"`
 }
 
+// ExperimentStats represents the response data for experiment statistics
+type ExperimentStats struct {
+	ExperimentStats model.GetExperimentStatsResponse `json:"getExperimentStats"`
+}
+
+// ExperimentRunStats represents the response data for experiment run statistics
+type ExperimentRunStats struct {
+	ExperimentRunStats model.GetExperimentRunStatsResponse `json:"getExperimentRunStats"`
+}
+
+// StopExperimentRunsData represents the response data for stopping experiment runs
+type StopExperimentRunsData struct {
+	IsStopped bool `json:"stopExperimentRuns"`
+}
+
+// ExecutionData is the workflow execution state recorded on an experiment run
+type ExecutionData struct {
+	ExperimentType string                   `json:"experimentType"`
+	ExperimentID   string                   `json:"experimentID"`
+	RevisionID     string                   `json:"revisionID"`
+	Name           string                   `json:"name"`
+	Namespace      string                   `json:"namespace"`
+	Phase          string                   `json:"phase"`
+	Message        string                   `json:"message"`
+	StartedAt      string                   `json:"startedAt"`
+	FinishedAt     string                   `json:"finishedAt"`
+	Nodes          map[string]ExecutionNode `json:"nodes"`
+}
+
+// ExecutionNode is a single step of an experiment run
+type ExecutionNode struct {
+	Name       string     `json:"name"`
+	Phase      string     `json:"phase"`
+	Message    string     `json:"message"`
+	StartedAt  string     `json:"startedAt"`
+	FinishedAt string     `json:"finishedAt"`
+	Children   []string   `json:"children"`
+	Type       string     `json:"type"`
+	ChaosData  *ChaosData `json:"chaosData,omitempty"`
+}
+
+// ChaosData is the fault execution data reported by the chaos exporter for a node
+type ChaosData struct {
+	EngineName             string       `json:"engineName"`
+	Namespace              string       `json:"namespace"`
+	ExperimentName         string       `json:"experimentName"`
+	ExperimentStatus       string       `json:"experimentStatus"`
+	ExperimentVerdict      string       `json:"experimentVerdict"`
+	ProbeSuccessPercentage string       `json:"probeSuccessPercentage"`
+	FailStep               string       `json:"failStep"`
+	ChaosResult            *ChaosResult `json:"chaosResult,omitempty"`
+}
+
+// ChaosResult is the subset of the ChaosResult resource the SDK relies on
+type ChaosResult struct {
+	Status struct {
+		ProbeStatuses []ProbeStatus `json:"probeStatuses"`
+	} `json:"status"`
+}
+
+// ProbeStatus is the verdict of a probe within a fault
+type ProbeStatus struct {
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Mode   string `json:"mode"`
+	Status struct {
+		Verdict     string `json:"verdict"`
+		Description string `json:"description"`
+	} `json:"status"`
+}
+
+// UpdateCronExperimentStateData represents the response data for enabling or disabling a cron experiment
+type UpdateCronExperimentStateData struct {
+	IsUpdated bool `json:"updateCronExperimentState"`
+}
+
 // SaveChaosExperimentGraphQLRequest represents the GraphQL request for saving an experiment
 type SaveChaosExperimentGraphQLRequest struct {
 	Query     string `json:"query"`
@@ -82,3 +158,16 @@ type DeleteChaosExperimentGraphQLRequest struct {
 		ExperimentRunID *string `json:"experimentRunID"`
 	} `json:"variables"`
 }
+
+// ExperimentRevision represents a distinct version of an experiment manifest
+type ExperimentRevision struct {
+	// RevisionID is a content fingerprint of the manifest, suffixed with its
+	// occurrence when the same manifest recurs later in the history
+	RevisionID         string   `json:"revisionID"`
+	ExperimentID       string   `json:"experimentID"`
+	ExperimentManifest string   `json:"experimentManifest"`
+	UpdatedAt          string   `json:"updatedAt"`
+	UpdatedBy          string   `json:"updatedBy,omitempty"`
+	ExperimentRunIDs   []string `json:"experimentRunIDs,omitempty"`
+	IsCurrent          bool     `json:"isCurrent"`
+}
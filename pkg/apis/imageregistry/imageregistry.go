@@ -0,0 +1,45 @@
+package imageregistry
+
+import (
+	"fmt"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/types"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/utils"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+// ListImageRegistry lists the image registry settings of the specified project
+func ListImageRegistry(pid string, cred types.Credentials) (ListImageRegistryData, error) {
+	if pid == "" {
+		return ListImageRegistryData{}, fmt.Errorf("project ID cannot be empty")
+	}
+
+	return utils.SendGraphQLRequest[ListImageRegistryData](
+		fmt.Sprintf("%s%s", cred.Endpoint, utils.GQLAPIPath),
+		cred.Token,
+		ListImageRegistryQuery,
+		struct {
+			ProjectID string `json:"projectID"`
+		}{
+			ProjectID: pid,
+		},
+		"Error in Getting Image Registry List",
+	)
+}
+
+// CreateImageRegistry saves image registry settings in the specified project
+func CreateImageRegistry(pid string, request models.ImageRegistryInput, cred types.Credentials) (CreateImageRegistryData, error) {
+	return utils.SendGraphQLRequest[CreateImageRegistryData](
+		fmt.Sprintf("%s%s", cred.Endpoint, utils.GQLAPIPath),
+		cred.Token,
+		CreateImageRegistryQuery,
+		struct {
+			ProjectID         string                    `json:"projectID"`
+			ImageRegistryInfo models.ImageRegistryInput `json:"imageRegistryInfo"`
+		}{
+			ProjectID:         pid,
+			ImageRegistryInfo: request,
+		},
+		"Error in Creating Image Registry",
+	)
+}
@@ -0,0 +1,36 @@
+package imageregistry
+
+const (
+	ListImageRegistryQuery = `query listImageRegistry($projectID: String!) {
+					listImageRegistry(projectID: $projectID) {
+						imageRegistryID
+						projectID
+						isDefault
+						isRemoved
+						imageRegistryInfo {
+							isDefault
+							imageRegistryName
+							imageRepoName
+							imageRegistryType
+							secretName
+							secretNamespace
+							enableRegistry
+						}
+						createdAt
+						updatedAt
+					}
+				}`
+
+	CreateImageRegistryQuery = `mutation createImageRegistry($projectID: String!, $imageRegistryInfo: ImageRegistryInput!) {
+					createImageRegistry(projectID: $projectID, imageRegistryInfo: $imageRegistryInfo) {
+						imageRegistryID
+						projectID
+						isDefault
+						imageRegistryInfo {
+							imageRegistryName
+							imageRepoName
+							imageRegistryType
+						}
+					}
+				}`
+)
@@ -0,0 +1,13 @@
+package imageregistry
+
+import model "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+
+// Type for ListImageRegistry
+type ListImageRegistryData struct {
+	ListImageRegistry []*model.ImageRegistryResponse `json:"listImageRegistry"`
+}
+
+// Type for CreateImageRegistry
+type CreateImageRegistryData struct {
+	CreateImageRegistry model.ImageRegistryResponse `json:"createImageRegistry"`
+}
@@ -28,6 +28,16 @@ const (
 							name
 							isActive
 							environmentID
+							tags
+							description
+							platformName
+							infraScope
+							infraNamespace
+							serviceAccount
+							infraNsExists
+							infraSaExists
+							infraType
+							isRemoved
 						}
 					}
 					}`
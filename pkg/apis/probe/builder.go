@@ -0,0 +1,347 @@
+package probe
+
+import (
+	"strconv"
+	"time"
+)
+
+// Defaults of the probe builders, matching the ChaosCenter UI
+const (
+	DefaultProbeTimeout = 10 * time.Second
+	DefaultInterval     = 2 * time.Second
+	DefaultAttempt      = 1
+)
+
+// common holds the fields shared by every probe builder
+type common struct {
+	request  ProbeRequest
+	timeout  time.Duration
+	interval time.Duration
+	attempt  int
+}
+
+func newCommon(name string, probeType ProbeType) common {
+	return common{
+		request: ProbeRequest{
+			Name:               name,
+			Type:               probeType,
+			InfrastructureType: InfrastructureTypeKubernetes,
+		},
+		timeout:  DefaultProbeTimeout,
+		interval: DefaultInterval,
+		attempt:  DefaultAttempt,
+	}
+}
+
+func (c *common) build() (ProbeRequest, error) {
+	request := c.request
+	if err := request.Validate(); err != nil {
+		return ProbeRequest{}, err
+	}
+	return request, nil
+}
+
+func (c *common) setDescription(description string) {
+	c.request.Description = &description
+}
+
+func (c *common) addTags(tags []string) {
+	c.request.Tags = append(c.request.Tags, tags...)
+}
+
+// HTTPProbeBuilder builds an httpProbe request
+type HTTPProbeBuilder struct {
+	common
+	properties KubernetesHTTPProbeRequest
+}
+
+// NewHTTPProbe starts an httpProbe calling url. The expected response is set with Get or Post.
+func NewHTTPProbe(name string, url string) *HTTPProbeBuilder {
+	return &HTTPProbeBuilder{
+		common:     newCommon(name, ProbeTypeHTTPProbe),
+		properties: KubernetesHTTPProbeRequest{URL: url},
+	}
+}
+
+// Description sets the probe description
+func (b *HTTPProbeBuilder) Description(description string) *HTTPProbeBuilder {
+	b.setDescription(description)
+	return b
+}
+
+// Tags adds tags to the probe
+func (b *HTTPProbeBuilder) Tags(tags ...string) *HTTPProbeBuilder {
+	b.addTags(tags)
+	return b
+}
+
+// Timeout sets the timeout of a single probe attempt
+func (b *HTTPProbeBuilder) Timeout(timeout time.Duration) *HTTPProbeBuilder {
+	b.timeout = timeout
+	return b
+}
+
+// Interval sets the time between probe attempts
+func (b *HTTPProbeBuilder) Interval(interval time.Duration) *HTTPProbeBuilder {
+	b.interval = interval
+	return b
+}
+
+// Attempt sets the number of attempts before the probe fails
+func (b *HTTPProbeBuilder) Attempt(attempt int) *HTTPProbeBuilder {
+	b.attempt = attempt
+	return b
+}
+
+// InsecureSkipVerify disables TLS certificate verification
+func (b *HTTPProbeBuilder) InsecureSkipVerify() *HTTPProbeBuilder {
+	insecure := true
+	b.properties.InsecureSkipVerify = &insecure
+	return b
+}
+
+// Get sends a GET request and compares the response code with criteria (==, != or oneOf)
+func (b *HTTPProbeBuilder) Get(criteria string, responseCode string) *HTTPProbeBuilder {
+	b.properties.Method = &Method{Get: &GetMethod{Criteria: criteria, ResponseCode: responseCode}}
+	return b
+}
+
+// Post sends a POST request with the given body and compares the response code with criteria
+func (b *HTTPProbeBuilder) Post(contentType string, body string, criteria string, responseCode string) *HTTPProbeBuilder {
+	b.properties.Method = &Method{Post: &PostMethod{
+		ContentType:  &contentType,
+		Body:         &body,
+		Criteria:     criteria,
+		ResponseCode: responseCode,
+	}}
+	return b
+}
+
+// Build validates the probe and returns its request
+func (b *HTTPProbeBuilder) Build() (ProbeRequest, error) {
+	properties := b.properties
+	properties.ProbeTimeout, properties.Interval, properties.Attempt = b.timing()
+	b.request.KubernetesHTTPProperties = &properties
+	return b.build()
+}
+
+// CMDProbeBuilder builds a cmdProbe request
+type CMDProbeBuilder struct {
+	common
+	properties KubernetesCMDProbeRequest
+}
+
+// NewCMDProbe starts a cmdProbe running command. The expected output is set with Comparator.
+func NewCMDProbe(name string, command string) *CMDProbeBuilder {
+	return &CMDProbeBuilder{
+		common:     newCommon(name, ProbeTypeCMDProbe),
+		properties: KubernetesCMDProbeRequest{Command: command},
+	}
+}
+
+// Description sets the probe description
+func (b *CMDProbeBuilder) Description(description string) *CMDProbeBuilder {
+	b.setDescription(description)
+	return b
+}
+
+// Tags adds tags to the probe
+func (b *CMDProbeBuilder) Tags(tags ...string) *CMDProbeBuilder {
+	b.addTags(tags)
+	return b
+}
+
+// Timeout sets the timeout of a single probe attempt
+func (b *CMDProbeBuilder) Timeout(timeout time.Duration) *CMDProbeBuilder {
+	b.timeout = timeout
+	return b
+}
+
+// Interval sets the time between probe attempts
+func (b *CMDProbeBuilder) Interval(interval time.Duration) *CMDProbeBuilder {
+	b.interval = interval
+	return b
+}
+
+// Attempt sets the number of attempts before the probe fails
+func (b *CMDProbeBuilder) Attempt(attempt int) *CMDProbeBuilder {
+	b.attempt = attempt
+	return b
+}
+
+// Comparator compares the command output, parsed as comparatorType (int, float
+// or string), with value using criteria
+func (b *CMDProbeBuilder) Comparator(comparatorType string, criteria string, value string) *CMDProbeBuilder {
+	b.properties.Comparator = &ComparatorInput{Type: comparatorType, Criteria: criteria, Value: value}
+	return b
+}
+
+// Source runs the command in a pod created from the given source JSON instead of the experiment pod
+func (b *CMDProbeBuilder) Source(source string) *CMDProbeBuilder {
+	b.properties.Source = &source
+	return b
+}
+
+// Build validates the probe and returns its request
+func (b *CMDProbeBuilder) Build() (ProbeRequest, error) {
+	properties := b.properties
+	properties.ProbeTimeout, properties.Interval, properties.Attempt = b.timing()
+	b.request.KubernetesCMDProperties = &properties
+	return b.build()
+}
+
+// K8SProbeBuilder builds a k8sProbe request
+type K8SProbeBuilder struct {
+	common
+	properties K8SProbeRequest
+}
+
+// NewK8SProbe starts a k8sProbe running operation (present, absent, create or
+// delete) on a resource, e.g. NewK8SProbe("pods-up", "v1", "pods", K8SOperationPresent)
+func NewK8SProbe(name string, version string, resource string, operation string) *K8SProbeBuilder {
+	return &K8SProbeBuilder{
+		common:     newCommon(name, ProbeTypeK8SProbe),
+		properties: K8SProbeRequest{Version: version, Resource: resource, Operation: operation},
+	}
+}
+
+// Description sets the probe description
+func (b *K8SProbeBuilder) Description(description string) *K8SProbeBuilder {
+	b.setDescription(description)
+	return b
+}
+
+// Tags adds tags to the probe
+func (b *K8SProbeBuilder) Tags(tags ...string) *K8SProbeBuilder {
+	b.addTags(tags)
+	return b
+}
+
+// Timeout sets the timeout of a single probe attempt
+func (b *K8SProbeBuilder) Timeout(timeout time.Duration) *K8SProbeBuilder {
+	b.timeout = timeout
+	return b
+}
+
+// Interval sets the time between probe attempts
+func (b *K8SProbeBuilder) Interval(interval time.Duration) *K8SProbeBuilder {
+	b.interval = interval
+	return b
+}
+
+// Attempt sets the number of attempts before the probe fails
+func (b *K8SProbeBuilder) Attempt(attempt int) *K8SProbeBuilder {
+	b.attempt = attempt
+	return b
+}
+
+// Group sets the API group of the resource, empty for the core group
+func (b *K8SProbeBuilder) Group(group string) *K8SProbeBuilder {
+	b.properties.Group = &group
+	return b
+}
+
+// Namespace sets the namespace of the resource
+func (b *K8SProbeBuilder) Namespace(namespace string) *K8SProbeBuilder {
+	b.properties.Namespace = namespace
+	return b
+}
+
+// ResourceNames restricts the probe to the comma separated resource names
+func (b *K8SProbeBuilder) ResourceNames(names string) *K8SProbeBuilder {
+	b.properties.ResourceNames = &names
+	return b
+}
+
+// FieldSelector restricts the probe to the resources matching a field selector
+func (b *K8SProbeBuilder) FieldSelector(selector string) *K8SProbeBuilder {
+	b.properties.FieldSelector = &selector
+	return b
+}
+
+// LabelSelector restricts the probe to the resources matching a label selector
+func (b *K8SProbeBuilder) LabelSelector(selector string) *K8SProbeBuilder {
+	b.properties.LabelSelector = &selector
+	return b
+}
+
+// Build validates the probe and returns its request
+func (b *K8SProbeBuilder) Build() (ProbeRequest, error) {
+	properties := b.properties
+	properties.ProbeTimeout, properties.Interval, properties.Attempt = b.timing()
+	b.request.K8SProperties = &properties
+	return b.build()
+}
+
+// PROMProbeBuilder builds a promProbe request
+type PROMProbeBuilder struct {
+	common
+	properties PROMProbeRequest
+}
+
+// NewPROMProbe starts a promProbe running query against a Prometheus endpoint.
+// The expected result is set with Comparator.
+func NewPROMProbe(name string, endpoint string, query string) *PROMProbeBuilder {
+	return &PROMProbeBuilder{
+		common:     newCommon(name, ProbeTypePROMProbe),
+		properties: PROMProbeRequest{Endpoint: endpoint, Query: query},
+	}
+}
+
+// Description sets the probe description
+func (b *PROMProbeBuilder) Description(description string) *PROMProbeBuilder {
+	b.setDescription(description)
+	return b
+}
+
+// Tags adds tags to the probe
+func (b *PROMProbeBuilder) Tags(tags ...string) *PROMProbeBuilder {
+	b.addTags(tags)
+	return b
+}
+
+// Timeout sets the timeout of a single probe attempt
+func (b *PROMProbeBuilder) Timeout(timeout time.Duration) *PROMProbeBuilder {
+	b.timeout = timeout
+	return b
+}
+
+// Interval sets the time between probe attempts
+func (b *PROMProbeBuilder) Interval(interval time.Duration) *PROMProbeBuilder {
+	b.interval = interval
+	return b
+}
+
+// Attempt sets the number of attempts before the probe fails
+func (b *PROMProbeBuilder) Attempt(attempt int) *PROMProbeBuilder {
+	b.attempt = attempt
+	return b
+}
+
+// Comparator compares the query result with value using a numeric criteria
+func (b *PROMProbeBuilder) Comparator(criteria string, value string) *PROMProbeBuilder {
+	b.properties.Comparator = &ComparatorInput{Type: ComparatorTypeFloat, Criteria: criteria, Value: value}
+	return b
+}
+
+// Build validates the probe and returns its request
+func (b *PROMProbeBuilder) Build() (ProbeRequest, error) {
+	properties := b.properties
+	properties.ProbeTimeout, properties.Interval, properties.Attempt = b.timing()
+	b.request.PROMProperties = &properties
+	return b.build()
+}
+
+// timing formats the durations as the Go duration strings stored by ChaosCenter
+func (c *common) timing() (string, string, *int) {
+	attempt := c.attempt
+	return formatDuration(c.timeout), formatDuration(c.interval), &attempt
+}
+
+// formatDuration renders whole seconds as e.g. 90s rather than 1m30s
+func formatDuration(d time.Duration) string {
+	if d > 0 && d%time.Second == 0 {
+		return strconv.FormatInt(int64(d/time.Second), 10) + "s"
+	}
+	return d.String()
+}
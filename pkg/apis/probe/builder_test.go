@@ -0,0 +1,157 @@
+package probe
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPProbeBuilder(t *testing.T) {
+	request, err := NewHTTPProbe("checkout-up", "https://checkout.shop.svc/health").
+		Description("checkout answers").
+		Tags("shop").
+		Get("==", "200").
+		Timeout(5 * time.Second).
+		Interval(90 * time.Second).
+		InsecureSkipVerify().
+		Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, ProbeTypeHTTPProbe, request.Type)
+	assert.Equal(t, InfrastructureTypeKubernetes, request.InfrastructureType)
+	assert.Equal(t, []string{"shop"}, request.Tags)
+	require.NotNil(t, request.KubernetesHTTPProperties)
+	assert.Equal(t, "5s", request.KubernetesHTTPProperties.ProbeTimeout)
+	assert.Equal(t, "90s", request.KubernetesHTTPProperties.Interval)
+	assert.Equal(t, 1, *request.KubernetesHTTPProperties.Attempt)
+	assert.True(t, *request.KubernetesHTTPProperties.InsecureSkipVerify)
+	assert.Equal(t, &GetMethod{Criteria: "==", ResponseCode: "200"}, request.KubernetesHTTPProperties.Method.Get)
+}
+
+func TestProbeBuildersValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		build  func() (ProbeRequest, error)
+		fields []string
+	}{
+		{
+			name:  "valid cmd probe",
+			build: NewCMDProbe("disk", "df --output=pcent / | tail -1").Comparator(ComparatorTypeInt, "<", "90").Build,
+		},
+		{
+			name:  "valid k8s probe",
+			build: NewK8SProbe("pods", "v1", "pods", K8SOperationPresent).Namespace("shop").LabelSelector("app=checkout").Build,
+		},
+		{
+			name:  "valid prom probe",
+			build: NewPROMProbe("latency", "http://prometheus:9090", "avg(latency)").Comparator("between", "[0,0.5]").Build,
+		},
+		{
+			name:   "http without method",
+			build:  NewHTTPProbe("h", "http://svc").Build,
+			fields: []string{"kubernetesHTTPProperties.method"},
+		},
+		{
+			name:   "http oneOf with invalid status",
+			build:  NewHTTPProbe("h", "http://svc").Get("oneOf", "[200,999]").Build,
+			fields: []string{"kubernetesHTTPProperties.method.get.responseCode"},
+		},
+		{
+			name:   "http string criteria",
+			build:  NewHTTPProbe("h", "http://svc").Post("application/json", "{}", "equal", "200").Build,
+			fields: []string{"kubernetesHTTPProperties.method.post.criteria"},
+		},
+		{
+			name:   "non positive durations",
+			build:  NewHTTPProbe("h", "http://svc").Get("==", "200").Timeout(0).Interval(-time.Second).Attempt(0).Build,
+			fields: []string{"kubernetesHTTPProperties.probeTimeout", "kubernetesHTTPProperties.interval", "kubernetesHTTPProperties.attempt"},
+		},
+		{
+			name:   "cmd without comparator",
+			build:  NewCMDProbe("c", "true").Build,
+			fields: []string{"kubernetesCMDProperties.comparator"},
+		},
+		{
+			name:   "cmd numeric criteria on strings",
+			build:  NewCMDProbe("c", "true").Comparator(ComparatorTypeString, "==", "ok").Build,
+			fields: []string{"kubernetesCMDProperties.comparator.criteria"},
+		},
+		{
+			name:   "cmd int value",
+			build:  NewCMDProbe("c", "true").Comparator(ComparatorTypeInt, ">=", "1.5").Build,
+			fields: []string{"kubernetesCMDProperties.comparator.value"},
+		},
+		{
+			name:   "cmd invalid regex",
+			build:  NewCMDProbe("c", "true").Comparator(ComparatorTypeString, "matches", "(").Build,
+			fields: []string{"kubernetesCMDProperties.comparator.value"},
+		},
+		{
+			name:   "cmd unknown type",
+			build:  NewCMDProbe("c", "true").Comparator("Contains", "==", "ok").Build,
+			fields: []string{"kubernetesCMDProperties.comparator.type"},
+		},
+		{
+			name:   "k8s unknown operation",
+			build:  NewK8SProbe("k", "v1", "pods", "exists").Build,
+			fields: []string{"k8sProperties.operation"},
+		},
+		{
+			name:   "prom value not a number",
+			build:  NewPROMProbe("p", "http://prometheus:9090", "up").Comparator(">=", "one").Build,
+			fields: []string{"promProperties.comparator.value"},
+		},
+		{
+			name:   "prom invalid query",
+			build:  NewPROMProbe("p", "http://prometheus:9090", "sum(rate(x[5m])").Comparator(">=", "1").Build,
+			fields: []string{"promProperties.query"},
+		},
+		{
+			name:   "prom endpoint without scheme",
+			build:  NewPROMProbe("p", "prometheus:9090", "up").Comparator(">=", "1").Build,
+			fields: []string{"promProperties.endpoint"},
+		},
+		{
+			name:   "prom string criteria",
+			build:  NewPROMProbe("p", "http://prometheus:9090", "up").Comparator("contains", "1").Build,
+			fields: []string{"promProperties.comparator.criteria"},
+		},
+		{
+			name:   "prom between with one bound",
+			build:  NewPROMProbe("p", "http://prometheus:9090", "up").Comparator("between", "[1]").Build,
+			fields: []string{"promProperties.comparator.value"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.build()
+			if len(tt.fields) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tt.fields, errorFields(err))
+		})
+	}
+}
+
+func TestValidateKeepsStructuralErrors(t *testing.T) {
+	err := ProbeRequest{Name: "p", Type: ProbeTypeHTTPProbe}.Validate()
+	assert.EqualError(t, err, "no probe properties provided")
+}
+
+// errorFields lists the fields of the FieldErrors joined in err
+func errorFields(err error) []string {
+	var fields []string
+	for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var fieldErr *FieldError
+		if errors.As(e, &fieldErr) {
+			fields = append(fields, fieldErr.Field)
+		}
+	}
+	return fields
+}
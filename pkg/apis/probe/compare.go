@@ -0,0 +1,109 @@
+package probe
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Match reports whether an actual value, such as the output of a CMD probe or
+// the result of a PROM probe query, satisfies the comparator the way the probe
+// runtime evaluates it. An error is returned when the comparator is invalid or
+// when the actual value does not parse as the comparator type.
+func (c ComparatorInput) Match(actual string) (bool, error) {
+	allowed, ok := comparatorCriteria[c.Type]
+	if !ok {
+		return false, fmt.Errorf("invalid comparator type %q", c.Type)
+	}
+	if !contains(allowed, c.Criteria) {
+		return false, fmt.Errorf("invalid criteria %q for type %s", c.Criteria, c.Type)
+	}
+
+	expected := []string{c.Value}
+	if c.Criteria == "oneOf" || c.Criteria == "between" {
+		var err error
+		if expected, err = parseList(c.Value); err != nil {
+			return false, err
+		}
+		if c.Criteria == "between" && len(expected) != 2 {
+			return false, fmt.Errorf("between takes exactly two bounds, got %d", len(expected))
+		}
+	}
+
+	if c.Type == ComparatorTypeString {
+		return matchString(c.Criteria, strings.TrimSpace(actual), expected)
+	}
+	return matchNumber(c.Type, c.Criteria, strings.TrimSpace(actual), expected)
+}
+
+func matchString(criteria, actual string, expected []string) (bool, error) {
+	switch criteria {
+	case "equal":
+		return actual == expected[0], nil
+	case "notEqual":
+		return actual != expected[0], nil
+	case "contains":
+		return strings.Contains(actual, expected[0]), nil
+	case "oneOf":
+		return contains(expected, actual), nil
+	default:
+		re, err := regexp.Compile(expected[0])
+		if err != nil {
+			return false, fmt.Errorf("invalid regular expression: %v", err)
+		}
+		return re.MatchString(actual) == (criteria == "matches"), nil
+	}
+}
+
+func matchNumber(comparatorType, criteria, actual string, expected []string) (bool, error) {
+	parse := func(s string) (float64, error) {
+		if comparatorType == ComparatorTypeInt {
+			n, err := strconv.Atoi(s)
+			if err != nil {
+				return 0, fmt.Errorf("%q is not an integer", s)
+			}
+			return float64(n), nil
+		}
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%q is not a number", s)
+		}
+		return f, nil
+	}
+
+	value, err := parse(actual)
+	if err != nil {
+		return false, err
+	}
+	bounds := make([]float64, len(expected))
+	for i, e := range expected {
+		if bounds[i], err = parse(e); err != nil {
+			return false, err
+		}
+	}
+
+	switch criteria {
+	case "==":
+		return value == bounds[0], nil
+	case "!=":
+		return value != bounds[0], nil
+	case "<":
+		return value < bounds[0], nil
+	case ">":
+		return value > bounds[0], nil
+	case "<=":
+		return value <= bounds[0], nil
+	case ">=":
+		return value >= bounds[0], nil
+	case "between":
+		return value >= bounds[0] && value <= bounds[1], nil
+	default: // oneOf
+		for _, b := range bounds {
+			if value == b {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
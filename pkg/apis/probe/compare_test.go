@@ -0,0 +1,38 @@
+package probe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComparatorMatch(t *testing.T) {
+	tests := []struct {
+		comparator ComparatorInput
+		actual     string
+		match      bool
+		err        string
+	}{
+		{comparator: ComparatorInput{Type: ComparatorTypeInt, Criteria: ">=", Value: "3"}, actual: " 3\n", match: true},
+		{comparator: ComparatorInput{Type: ComparatorTypeInt, Criteria: "oneOf", Value: "[1, 2]"}, actual: "3"},
+		{comparator: ComparatorInput{Type: ComparatorTypeFloat, Criteria: "between", Value: "[0.1,0.2]"}, actual: "0.15", match: true},
+		{comparator: ComparatorInput{Type: ComparatorTypeFloat, Criteria: "<", Value: "1"}, actual: "NaN?", err: `"NaN?" is not a number`},
+		{comparator: ComparatorInput{Type: ComparatorTypeString, Criteria: "contains", Value: "ready"}, actual: "pod ready", match: true},
+		{comparator: ComparatorInput{Type: ComparatorTypeString, Criteria: "oneOf", Value: "[Running,Succeeded]"}, actual: "Pending"},
+		{comparator: ComparatorInput{Type: ComparatorTypeString, Criteria: "matches", Value: "^v[0-9]+$"}, actual: "v12", match: true},
+		{comparator: ComparatorInput{Type: ComparatorTypeString, Criteria: "notMatches", Value: "error"}, actual: "no errors"},
+		{comparator: ComparatorInput{Type: ComparatorTypeString, Criteria: ">", Value: "a"}, actual: "b", err: `invalid criteria ">" for type string`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.comparator.Criteria+" "+tt.actual, func(t *testing.T) {
+			match, err := tt.comparator.Match(tt.actual)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.match, match)
+		})
+	}
+}
@@ -0,0 +1,237 @@
+package probe
+
+import (
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+// RequestFromModel converts a probe fetched from ChaosCenter into the request
+// which creates or updates it, so that a probe can be fetched, modified and
+// submitted again, or recreated in another project. Every property which can
+// be set on a probe is kept; server managed fields such as timestamps,
+// authors and recent executions are dropped.
+func RequestFromModel(p models.Probe) ProbeRequest {
+	request := ProbeRequest{
+		Name:               p.Name,
+		Type:               ProbeType(p.Type),
+		InfrastructureType: InfrastructureType(p.InfrastructureType),
+		Tags:               p.Tags,
+	}
+	if p.Description != nil && *p.Description != "" {
+		description := *p.Description
+		request.Description = &description
+	}
+
+	if http := p.KubernetesHTTPProperties; http != nil {
+		request.KubernetesHTTPProperties = &KubernetesHTTPProbeRequest{
+			ProbeTimeout:         http.ProbeTimeout,
+			Interval:             http.Interval,
+			Retry:                http.Retry,
+			Attempt:              http.Attempt,
+			ProbePollingInterval: http.ProbePollingInterval,
+			InitialDelay:         http.InitialDelay,
+			EvaluationTimeout:    http.EvaluationTimeout,
+			StopOnFailure:        http.StopOnFailure,
+			URL:                  http.URL,
+			InsecureSkipVerify:   http.InsecureSkipVerify,
+		}
+		if http.Method != nil {
+			method := &Method{}
+			if get := http.Method.Get; get != nil {
+				method.Get = &GetMethod{ResponseCode: get.ResponseCode, Criteria: get.Criteria}
+			}
+			if post := http.Method.Post; post != nil {
+				method.Post = &PostMethod{
+					Body:         post.Body,
+					BodyPath:     post.BodyPath,
+					ContentType:  post.ContentType,
+					ResponseCode: post.ResponseCode,
+					Criteria:     post.Criteria,
+				}
+			}
+			request.KubernetesHTTPProperties.Method = method
+		}
+	}
+
+	if cmd := p.KubernetesCMDProperties; cmd != nil {
+		request.KubernetesCMDProperties = &KubernetesCMDProbeRequest{
+			Command:              cmd.Command,
+			Comparator:           comparatorFromModel(cmd.Comparator),
+			Source:               cmd.Source,
+			ProbeTimeout:         cmd.ProbeTimeout,
+			Interval:             cmd.Interval,
+			Retry:                cmd.Retry,
+			Attempt:              cmd.Attempt,
+			ProbePollingInterval: cmd.ProbePollingInterval,
+			InitialDelay:         cmd.InitialDelay,
+			EvaluationTimeout:    cmd.EvaluationTimeout,
+			StopOnFailure:        cmd.StopOnFailure,
+		}
+	}
+
+	if k8s := p.K8sProperties; k8s != nil {
+		request.K8SProperties = &K8SProbeRequest{
+			ProbeTimeout:         k8s.ProbeTimeout,
+			Interval:             k8s.Interval,
+			Retry:                k8s.Retry,
+			Attempt:              k8s.Attempt,
+			ProbePollingInterval: k8s.ProbePollingInterval,
+			InitialDelay:         k8s.InitialDelay,
+			EvaluationTimeout:    k8s.EvaluationTimeout,
+			StopOnFailure:        k8s.StopOnFailure,
+			Group:                k8s.Group,
+			Version:              k8s.Version,
+			Resource:             k8s.Resource,
+			ResourceNames:        k8s.ResourceNames,
+			FieldSelector:        k8s.FieldSelector,
+			LabelSelector:        k8s.LabelSelector,
+			Operation:            k8s.Operation,
+		}
+		if k8s.Namespace != nil {
+			request.K8SProperties.Namespace = *k8s.Namespace
+		}
+	}
+
+	if prom := p.PromProperties; prom != nil {
+		request.PROMProperties = &PROMProbeRequest{
+			Endpoint:             prom.Endpoint,
+			QueryPath:            prom.QueryPath,
+			Comparator:           comparatorFromModel(prom.Comparator),
+			ProbeTimeout:         prom.ProbeTimeout,
+			Interval:             prom.Interval,
+			Retry:                prom.Retry,
+			Attempt:              prom.Attempt,
+			ProbePollingInterval: prom.ProbePollingInterval,
+			InitialDelay:         prom.InitialDelay,
+			EvaluationTimeout:    prom.EvaluationTimeout,
+			StopOnFailure:        prom.StopOnFailure,
+		}
+		if prom.Query != nil {
+			request.PROMProperties.Query = *prom.Query
+		}
+	}
+
+	return request
+}
+
+// ModelFromRequest converts a request into the probe ChaosCenter stores for
+// it. It is the inverse of RequestFromModel: converting the result back gives
+// the original request.
+func ModelFromRequest(r ProbeRequest) models.Probe {
+	p := models.Probe{
+		Name:               r.Name,
+		Type:               models.ProbeType(r.Type),
+		InfrastructureType: models.InfrastructureType(r.InfrastructureType),
+		Tags:               r.Tags,
+	}
+	if r.Description != nil && *r.Description != "" {
+		description := *r.Description
+		p.Description = &description
+	}
+
+	if http := r.KubernetesHTTPProperties; http != nil {
+		p.KubernetesHTTPProperties = &models.KubernetesHTTPProbe{
+			ProbeTimeout:         http.ProbeTimeout,
+			Interval:             http.Interval,
+			Retry:                http.Retry,
+			Attempt:              http.Attempt,
+			ProbePollingInterval: http.ProbePollingInterval,
+			InitialDelay:         http.InitialDelay,
+			EvaluationTimeout:    http.EvaluationTimeout,
+			StopOnFailure:        http.StopOnFailure,
+			URL:                  http.URL,
+			InsecureSkipVerify:   http.InsecureSkipVerify,
+		}
+		if http.Method != nil {
+			method := &models.Method{}
+			if get := http.Method.Get; get != nil {
+				method.Get = &models.Get{ResponseCode: get.ResponseCode, Criteria: get.Criteria}
+			}
+			if post := http.Method.Post; post != nil {
+				method.Post = &models.Post{
+					Body:         post.Body,
+					BodyPath:     post.BodyPath,
+					ContentType:  post.ContentType,
+					ResponseCode: post.ResponseCode,
+					Criteria:     post.Criteria,
+				}
+			}
+			p.KubernetesHTTPProperties.Method = method
+		}
+	}
+
+	if cmd := r.KubernetesCMDProperties; cmd != nil {
+		p.KubernetesCMDProperties = &models.KubernetesCMDProbe{
+			Command:              cmd.Command,
+			Comparator:           comparatorToModel(cmd.Comparator),
+			Source:               cmd.Source,
+			ProbeTimeout:         cmd.ProbeTimeout,
+			Interval:             cmd.Interval,
+			Retry:                cmd.Retry,
+			Attempt:              cmd.Attempt,
+			ProbePollingInterval: cmd.ProbePollingInterval,
+			InitialDelay:         cmd.InitialDelay,
+			EvaluationTimeout:    cmd.EvaluationTimeout,
+			StopOnFailure:        cmd.StopOnFailure,
+		}
+	}
+
+	if k8s := r.K8SProperties; k8s != nil {
+		p.K8sProperties = &models.K8SProbe{
+			ProbeTimeout:         k8s.ProbeTimeout,
+			Interval:             k8s.Interval,
+			Retry:                k8s.Retry,
+			Attempt:              k8s.Attempt,
+			ProbePollingInterval: k8s.ProbePollingInterval,
+			InitialDelay:         k8s.InitialDelay,
+			EvaluationTimeout:    k8s.EvaluationTimeout,
+			StopOnFailure:        k8s.StopOnFailure,
+			Group:                k8s.Group,
+			Version:              k8s.Version,
+			Resource:             k8s.Resource,
+			ResourceNames:        k8s.ResourceNames,
+			FieldSelector:        k8s.FieldSelector,
+			LabelSelector:        k8s.LabelSelector,
+			Operation:            k8s.Operation,
+		}
+		if k8s.Namespace != "" {
+			namespace := k8s.Namespace
+			p.K8sProperties.Namespace = &namespace
+		}
+	}
+
+	if prom := r.PROMProperties; prom != nil {
+		p.PromProperties = &models.PROMProbe{
+			Endpoint:             prom.Endpoint,
+			QueryPath:            prom.QueryPath,
+			Comparator:           comparatorToModel(prom.Comparator),
+			ProbeTimeout:         prom.ProbeTimeout,
+			Interval:             prom.Interval,
+			Retry:                prom.Retry,
+			Attempt:              prom.Attempt,
+			ProbePollingInterval: prom.ProbePollingInterval,
+			InitialDelay:         prom.InitialDelay,
+			EvaluationTimeout:    prom.EvaluationTimeout,
+			StopOnFailure:        prom.StopOnFailure,
+		}
+		if prom.Query != "" {
+			query := prom.Query
+			p.PromProperties.Query = &query
+		}
+	}
+
+	return p
+}
+
+func comparatorFromModel(c *models.Comparator) *ComparatorInput {
+	if c == nil {
+		return nil
+	}
+	return &ComparatorInput{Type: c.Type, Criteria: c.Criteria, Value: c.Value}
+}
+
+func comparatorToModel(c *ComparatorInput) *models.Comparator {
+	if c == nil {
+		return nil
+	}
+	return &models.Comparator{Type: c.Type, Criteria: c.Criteria, Value: c.Value}
+}
@@ -0,0 +1,119 @@
+package probe
+
+import (
+	"testing"
+
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertRoundTrip(t *testing.T) {
+	description := "checks the shop"
+	retry, attempt := 2, 3
+	polling, delay, evaluation := "1s", "5s", "30s"
+	stop, insecure := true, true
+	body, bodyPath, contentType := `{"ping":true}`, "/mnt/body.json", "application/json"
+	source := `{"image":"busybox"}`
+	group, fieldSelector, labelSelector := "apps", "status.phase=Running", "app=checkout"
+	queryPath := "/mnt/query.promql"
+
+	requests := []ProbeRequest{
+		{
+			Name:               "http",
+			Description:        &description,
+			Type:               ProbeTypeHTTPProbe,
+			InfrastructureType: InfrastructureTypeKubernetes,
+			Tags:               []string{"shop"},
+			KubernetesHTTPProperties: &KubernetesHTTPProbeRequest{
+				ProbeTimeout:         "10s",
+				Interval:             "2s",
+				Retry:                &retry,
+				Attempt:              &attempt,
+				ProbePollingInterval: &polling,
+				InitialDelay:         &delay,
+				EvaluationTimeout:    &evaluation,
+				StopOnFailure:        &stop,
+				URL:                  "https://checkout.shop.svc",
+				InsecureSkipVerify:   &insecure,
+				Method: &Method{Post: &PostMethod{
+					ContentType:  &contentType,
+					Body:         &body,
+					BodyPath:     &bodyPath,
+					Criteria:     "==",
+					ResponseCode: "201",
+				}},
+			},
+		},
+		{
+			Name:               "cmd",
+			Type:               ProbeTypeCMDProbe,
+			InfrastructureType: InfrastructureTypeKubernetes,
+			KubernetesCMDProperties: &KubernetesCMDProbeRequest{
+				Command:      "cat /tmp/ready",
+				Comparator:   &ComparatorInput{Type: ComparatorTypeString, Criteria: "equal", Value: "ok"},
+				Source:       &source,
+				ProbeTimeout: "10s",
+				Interval:     "2s",
+				Attempt:      &attempt,
+			},
+		},
+		{
+			Name:               "k8s",
+			Type:               ProbeTypeK8SProbe,
+			InfrastructureType: InfrastructureTypeKubernetes,
+			K8SProperties: &K8SProbeRequest{
+				ProbeTimeout:  "10s",
+				Interval:      "2s",
+				Group:         &group,
+				Version:       "v1",
+				Resource:      "deployments",
+				Namespace:     "shop",
+				FieldSelector: &fieldSelector,
+				LabelSelector: &labelSelector,
+				Operation:     K8SOperationPresent,
+			},
+		},
+		{
+			Name:               "prom",
+			Type:               ProbeTypePROMProbe,
+			InfrastructureType: InfrastructureTypeKubernetes,
+			PROMProperties: &PROMProbeRequest{
+				Endpoint:     "http://prometheus:9090",
+				QueryPath:    &queryPath,
+				Comparator:   &ComparatorInput{Type: ComparatorTypeFloat, Criteria: "<=", Value: "0.5"},
+				ProbeTimeout: "10s",
+				Interval:     "2s",
+			},
+		},
+	}
+
+	for _, request := range requests {
+		t.Run(request.Name, func(t *testing.T) {
+			assert.Equal(t, request, RequestFromModel(ModelFromRequest(request)))
+		})
+	}
+}
+
+func TestRequestFromModelDropsServerFields(t *testing.T) {
+	query := "up"
+	p := models.Probe{
+		ProjectID: "project",
+		Name:      "prom",
+		Type:      models.ProbeTypePromProbe,
+		CreatedAt: "1000",
+		PromProperties: &models.PROMProbe{
+			Endpoint:     "http://prometheus:9090",
+			Query:        &query,
+			Comparator:   &models.Comparator{Type: ComparatorTypeFloat, Criteria: ">=", Value: "1"},
+			ProbeTimeout: "10s",
+			Interval:     "2s",
+		},
+	}
+
+	request := RequestFromModel(p)
+	assert.Equal(t, "up", request.PROMProperties.Query)
+	assert.NoError(t, request.Validate())
+
+	p.ProjectID, p.CreatedAt = "", ""
+	assert.Equal(t, p, ModelFromRequest(request))
+}
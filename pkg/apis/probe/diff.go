@@ -0,0 +1,84 @@
+package probe
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+// Diff lists the JSON paths of the fields set in want which differ in current,
+// e.g. kubernetesHTTPProperties.url. Fields want leaves out keep their server
+// defaults and are not compared. Tags are compared regardless of their order.
+func Diff(want ProbeRequest, current ProbeRequest) ([]string, error) {
+	var fields []string
+	if !sameStrings(want.Tags, current.Tags) {
+		fields = append(fields, "tags")
+	}
+	want.Tags, current.Tags = nil, nil
+
+	desired, err := toMap(want)
+	if err != nil {
+		return nil, err
+	}
+	actual, err := toMap(current)
+	if err != nil {
+		return nil, err
+	}
+	diffFields("", desired, actual, &fields)
+	return fields, nil
+}
+
+func toMap(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// diffFields appends the dotted path of every value of want which differs in got
+func diffFields(prefix string, want, got interface{}, fields *[]string) {
+	wantMap, ok := want.(map[string]interface{})
+	if !ok {
+		if !reflect.DeepEqual(want, got) {
+			*fields = append(*fields, prefix)
+		}
+		return
+	}
+
+	gotMap, _ := got.(map[string]interface{})
+	keys := make([]string, 0, len(wantMap))
+	for k := range wantMap {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+		diffFields(path, wantMap[k], gotMap[k], fields)
+	}
+}
+
+// sameStrings compares two lists regardless of their order
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, s := range a {
+		if !contains(b, s) {
+			return false
+		}
+	}
+	for _, s := range b {
+		if !contains(a, s) {
+			return false
+		}
+	}
+	return true
+}
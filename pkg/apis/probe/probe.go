@@ -14,7 +14,7 @@ func GetProbeRequest(pid string, probeName string, cred types.Credentials) (GetP
 	if probeName == "" {
 		return GetProbeResponse{}, fmt.Errorf("probe name cannot be empty")
 	}
-	return utils.SendGraphQLRequest[GetProbeResponse](
+	data, err := utils.SendGraphQLRequest[GetProbeResponseData](
 		fmt.Sprintf("%s%s", cred.Endpoint, utils.GQLAPIPath),
 		cred.Token,
 		GetProbeQuery,
@@ -27,24 +27,58 @@ func GetProbeRequest(pid string, probeName string, cred types.Credentials) (GetP
 		},
 		"Error in getting requested probe",
 	)
+	return GetProbeResponse{Data: data}, err
 }
 
 func ListProbeRequest(pid string, probeTypes []*models.ProbeType, cred types.Credentials) (ListProbeResponse, error) {
+	data, err := ListProbes(pid, nil, nil, models.ProbeFilterInput{Type: probeTypes}, cred)
+	return ListProbeResponse{Data: data}, err
+}
+
+// ListProbes lists the probes of a project with their name, type, tags and
+// timestamps. Probes are narrowed down by infrastructure type, exact names and
+// filter, nil or empty arguments match every probe.
+func ListProbes(pid string, infrastructureType *models.InfrastructureType, probeNames []string, filter models.ProbeFilterInput, cred types.Credentials) (ListProbeResponseData, error) {
 	if pid == "" {
-		return ListProbeResponse{}, fmt.Errorf("projectID cannot be empty")
+		return ListProbeResponseData{}, fmt.Errorf("projectID cannot be empty")
 	}
-	return utils.SendGraphQLRequest[ListProbeResponse](
+	return utils.SendGraphQLRequest[ListProbeResponseData](
 		fmt.Sprintf("%s%s", cred.Endpoint, utils.GQLAPIPath),
 		cred.Token,
 		ListProbeQuery,
 		struct {
-			ProjectID string                  `json:"projectID"`
-			Filter    models.ProbeFilterInput `json:"filter"`
+			ProjectID          string                     `json:"projectID"`
+			InfrastructureType *models.InfrastructureType `json:"infrastructureType,omitempty"`
+			ProbeNames         []string                   `json:"probeNames,omitempty"`
+			Filter             models.ProbeFilterInput    `json:"filter"`
 		}{
-			ProjectID: pid,
-			Filter: models.ProbeFilterInput{ // Assuming ProbeFilterInput is the correct type based on usage
-				Type: probeTypes,
-			},
+			ProjectID:          pid,
+			InfrastructureType: infrastructureType,
+			ProbeNames:         probeNames,
+			Filter:             filter,
+		},
+		"Error in listing probes",
+	)
+}
+
+// ListProbeDetails fetches the named probes along with their properties
+func ListProbeDetails(pid string, probeNames []string, cred types.Credentials) (ListProbeResponseData, error) {
+	if pid == "" {
+		return ListProbeResponseData{}, fmt.Errorf("projectID cannot be empty")
+	}
+	if len(probeNames) == 0 {
+		return ListProbeResponseData{}, fmt.Errorf("probe names cannot be empty")
+	}
+	return utils.SendGraphQLRequest[ListProbeResponseData](
+		fmt.Sprintf("%s%s", cred.Endpoint, utils.GQLAPIPath),
+		cred.Token,
+		ListProbeDetailsQuery,
+		struct {
+			ProjectID  string   `json:"projectID"`
+			ProbeNames []string `json:"probeNames"`
+		}{
+			ProjectID:  pid,
+			ProbeNames: probeNames,
 		},
 		"Error in listing probes",
 	)
@@ -54,7 +88,7 @@ func DeleteProbeRequest(pid string, probeName string, cred types.Credentials) (D
 	if probeName == "" {
 		return DeleteProbeResponse{}, fmt.Errorf("probe name cannot be empty")
 	}
-	return utils.SendGraphQLRequest[DeleteProbeResponse](
+	data, err := utils.SendGraphQLRequest[DeleteProbeResponseData](
 		fmt.Sprintf("%s%s", cred.Endpoint, utils.GQLAPIPath),
 		cred.Token,
 		DeleteProbeQuery,
@@ -67,10 +101,70 @@ func DeleteProbeRequest(pid string, probeName string, cred types.Credentials) (D
 		},
 		"Error in deleting probe",
 	)
+	return DeleteProbeResponse{Data: data}, err
+}
+
+// GetProbeReference lists the faults referencing a probe along with their recent executions
+func GetProbeReference(pid string, probeName string, cred types.Credentials) (GetProbeReferenceData, error) {
+	if probeName == "" {
+		return GetProbeReferenceData{}, fmt.Errorf("probe name cannot be empty")
+	}
+	return utils.SendGraphQLRequest[GetProbeReferenceData](
+		fmt.Sprintf("%s%s", cred.Endpoint, utils.GQLAPIPath),
+		cred.Token,
+		GetProbeReferenceQuery,
+		struct {
+			ProjectID string `json:"projectID"`
+			ProbeName string `json:"probeName"`
+		}{
+			ProjectID: pid,
+			ProbeName: probeName,
+		},
+		"Error in getting probe references",
+	)
+}
+
+// GetProbesInExperimentRun lists the probes attached to a fault of an experiment run with their verdicts
+func GetProbesInExperimentRun(pid string, experimentRunID string, faultName string, cred types.Credentials) (GetProbesInExperimentRunData, error) {
+	if experimentRunID == "" {
+		return GetProbesInExperimentRunData{}, fmt.Errorf("experiment run ID cannot be empty")
+	}
+	return utils.SendGraphQLRequest[GetProbesInExperimentRunData](
+		fmt.Sprintf("%s%s", cred.Endpoint, utils.GQLAPIPath),
+		cred.Token,
+		GetProbesInExperimentRunQuery,
+		struct {
+			ProjectID       string `json:"projectID"`
+			ExperimentRunID string `json:"experimentRunID"`
+			FaultName       string `json:"faultName"`
+		}{
+			ProjectID:       pid,
+			ExperimentRunID: experimentRunID,
+			FaultName:       faultName,
+		},
+		"Error in getting probes of experiment run",
+	)
+}
+
+// GetProbeYAML retrieves the definition of a probe in the given mode, as
+// ChaosCenter embeds it in the ChaosEngine of the faults referencing the probe
+func GetProbeYAML(pid string, probeName string, mode ProbeMode, cred types.Credentials) (ProbeSpec, error) {
+	if probeName == "" {
+		return ProbeSpec{}, fmt.Errorf("probe name cannot be empty")
+	}
+	if !mode.IsValid() {
+		return ProbeSpec{}, fmt.Errorf("invalid probe mode %q, expected one of %v", mode, AllProbeModes)
+	}
+
+	response, err := GetProbeYAMLRequest(pid, models.GetProbeYAMLRequest{ProbeName: probeName, Mode: mode}, cred)
+	if err != nil {
+		return ProbeSpec{}, err
+	}
+	return ParseProbeSpec(response.Data.GetProbeYAML)
 }
 
 func GetProbeYAMLRequest(pid string, request models.GetProbeYAMLRequest, cred types.Credentials) (GetProbeYAMLResponse, error) {
-	return utils.SendGraphQLRequest[GetProbeYAMLResponse](
+	data, err := utils.SendGraphQLRequest[GetProbeYAMLResponseData](
 		fmt.Sprintf("%s%s", cred.Endpoint, utils.GQLAPIPath),
 		cred.Token,
 		GetProbeYAMLQuery,
@@ -83,11 +177,63 @@ func GetProbeYAMLRequest(pid string, request models.GetProbeYAMLRequest, cred ty
 		},
 		"Error in getting probe details",
 	)
+	return GetProbeYAMLResponse{Data: data}, err
+}
+
+// ValidateUniqueProbe reports whether no probe of the project has the given name yet
+func ValidateUniqueProbe(pid string, probeName string, cred types.Credentials) (bool, error) {
+	if probeName == "" {
+		return false, fmt.Errorf("probe name cannot be empty")
+	}
+	response, err := utils.SendGraphQLRequest[ValidateUniqueProbeData](
+		fmt.Sprintf("%s%s", cred.Endpoint, utils.GQLAPIPath),
+		cred.Token,
+		ValidateUniqueProbeQuery,
+		struct {
+			ProjectID string `json:"projectID"`
+			ProbeName string `json:"probeName"`
+		}{
+			ProjectID: pid,
+			ProbeName: probeName,
+		},
+		"Error in validating probe name",
+	)
+	if err != nil {
+		return false, err
+	}
+	return response.ValidateUniqueProbe, nil
+}
+
+// AddProbe creates a new chaos probe and returns it as stored by ChaosCenter
+func AddProbe(request ProbeRequest, projectID string, cred types.Credentials) (models.Probe, error) {
+	if err := request.Validate(); err != nil {
+		return models.Probe{}, err
+	}
+
+	response, err := utils.SendGraphQLRequest[AddProbeResponseData](
+		fmt.Sprintf("%s%s", cred.Endpoint, utils.GQLAPIPath),
+		cred.Token,
+		createProbeMutation,
+		struct {
+			ProjectID string       `json:"projectID"`
+			Request   ProbeRequest `json:"request"`
+		}{
+			ProjectID: projectID,
+			Request:   request,
+		},
+		"Error in creating probe",
+	)
+	if err != nil {
+		return models.Probe{}, err
+	}
+	return response.AddProbe, nil
 }
 
 // CreateProbe creates a new chaos probe
+//
+// Deprecated: use AddProbe, which returns the same models.Probe as GetProbeRequest and ListProbes.
 func CreateProbeRequest(request ProbeRequest, projectID string, cred types.Credentials) (*Probe, error) {
-	if err := validateProbeRequest(request); err != nil {
+	if err := request.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -131,6 +277,34 @@ func CreateProbeRequest(request ProbeRequest, projectID string, cred types.Crede
 	return &probe, nil
 }
 
+// UpdateProbeRequest replaces the properties of an existing probe, identified by its name
+func UpdateProbeRequest(request ProbeRequest, projectID string, cred types.Credentials) (string, error) {
+	if err := request.Validate(); err != nil {
+		return "", err
+	}
+	if request.Name == "" {
+		return "", fmt.Errorf("probe name cannot be empty")
+	}
+
+	response, err := utils.SendGraphQLRequest[UpdateProbeResponseData](
+		fmt.Sprintf("%s%s", cred.Endpoint, utils.GQLAPIPath),
+		cred.Token,
+		UpdateProbeQuery,
+		struct {
+			Request   ProbeRequest `json:"request"`
+			ProjectID string       `json:"projectID"`
+		}{
+			Request:   request,
+			ProjectID: projectID,
+		},
+		"Error in updating probe",
+	)
+	if err != nil {
+		return "", err
+	}
+	return response.UpdateProbe, nil
+}
+
 func validateProbeRequest(request ProbeRequest) error {
 	propertiesSet := 0
 	if request.KubernetesHTTPProperties != nil {
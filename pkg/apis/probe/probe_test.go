@@ -1,6 +1,7 @@
 package probe
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -23,11 +24,13 @@ var (
 	testProbeID   string
 	testProbeName string
 	credentials types.Credentials
+	serverErr   error
 )
 
 func TestMain(m *testing.M) {
 	// Override defaults with environment variables if set
-	if endpoint := os.Getenv("LITMUS_TEST_ENDPOINT"); endpoint != "" {
+	endpoint := os.Getenv("LITMUS_TEST_ENDPOINT")
+	if endpoint != "" {
 		testEndpoint = endpoint
 	}
 	if username := os.Getenv("LITMUS_TEST_USERNAME"); username != "" {
@@ -39,6 +42,25 @@ func TestMain(m *testing.M) {
 
 	logger.Infof("Test configuration - Endpoint: %s, Username: %s", testEndpoint, testUsername)
 	
+	// The integration tests are skipped when no test server is configured, so
+	// that the unit tests of the package still run. A configured server which
+	// cannot be set up fails the tests, as in the other packages.
+	if endpoint == "" {
+		serverErr = errors.New("LITMUS_TEST_ENDPOINT is not set")
+		logger.Warnf("Skipping integration tests: %v", serverErr)
+	} else if err := setupServer(); err != nil {
+		log.Fatalf("Failed to set up test server: %v", err)
+	}
+
+	// Run the tests
+	exitCode := m.Run()
+	
+	// Exit with the test status code
+	os.Exit(exitCode)
+}
+
+// setupServer authenticates against the test server and selects a project
+func setupServer() error {
 	// Setup credentials by authenticating
 	authResp, err := apis.Auth(types.AuthInput{
 		Endpoint: testEndpoint,
@@ -46,7 +68,7 @@ func TestMain(m *testing.M) {
 		Password: testPassword,
 	})
 	if err != nil {
-		log.Fatalf("Failed to authenticate: %v", err)
+		return fmt.Errorf("failed to authenticate: %w", err)
 	}
 
 	credentials = types.Credentials{
@@ -57,7 +79,7 @@ func TestMain(m *testing.M) {
 	// Get or create project ID
 	projectResp, err := apis.ListProject(credentials)
 	if err != nil {
-		log.Fatalf("Failed to list projects: %v", err)
+		return fmt.Errorf("failed to list projects: %w", err)
 	}
 
 	if len(projectResp.Data.Projects) > 0 {
@@ -68,7 +90,7 @@ func TestMain(m *testing.M) {
 		projectName := fmt.Sprintf("test-project-%s", uuid.New().String())
 		newProject, err := apis.CreateProjectRequest(projectName, credentials)
 		if err != nil {
-			log.Fatalf("Failed to create project: %v", err)
+			return fmt.Errorf("failed to create project: %w", err)
 		}
 		projectID = newProject.Data.ID
 		logger.Infof("Created new project ID: %s", projectID)
@@ -76,12 +98,15 @@ func TestMain(m *testing.M) {
 	
 	// Store project ID in credentials for convenience
 	credentials.ProjectID = projectID
-	
-	// Run the tests
-	exitCode := m.Run()
-	
-	// Exit with the test status code
-	os.Exit(exitCode)
+	return nil
+}
+
+// requireServer skips integration tests when no test server is configured
+func requireServer(t *testing.T) {
+	t.Helper()
+	if serverErr != nil {
+		t.Skipf("test server not configured: %v", serverErr)
+	}
 }
 
 func init() {
@@ -130,6 +155,8 @@ func setupTestClient() (*LitmusClient, error) {
 
 // TestCreateProbe tests probe creation
 func TestCreateProbe(t *testing.T) {
+	requireServer(t)
+
 	trueBool := true
 	desc := "Test probe description"
 
@@ -219,8 +246,8 @@ func TestCreateProbe(t *testing.T) {
 				KubernetesCMDProperties: &KubernetesCMDProbeRequest{
 					Command: "ls -l",
 					Comparator: &ComparatorInput{
-						Type:     "Contains",
-						Criteria: "==",
+						Type:     "string",
+						Criteria: "contains",
 						Value:    "test",
 					},
 					ProbeTimeout: "30s",
@@ -249,8 +276,8 @@ func TestCreateProbe(t *testing.T) {
 						assert.Equal(t, "30s", probe.KubernetesCMDProperties.ProbeTimeout)
 						assert.Equal(t, "10s", probe.KubernetesCMDProperties.Interval)
 						if probe.KubernetesCMDProperties.Comparator != nil {
-							assert.Equal(t, "Contains", probe.KubernetesCMDProperties.Comparator.Type)
-							assert.Equal(t, "==", probe.KubernetesCMDProperties.Comparator.Criteria)
+							assert.Equal(t, "string", probe.KubernetesCMDProperties.Comparator.Type)
+							assert.Equal(t, "contains", probe.KubernetesCMDProperties.Comparator.Criteria)
 						}
 					}
 				}
@@ -371,6 +398,8 @@ func TestCreateProbe(t *testing.T) {
 
 // TestGetProbeRequest tests probe retrieval - runs after TestCreateProbe
 func TestGetProbeRequest(t *testing.T) {
+	requireServer(t)
+
     // Skip this test if no probe was created
     if testProbeID == "" {
         t.Skip("Skipping test because no probe ID is available. TestCreateProbe must run first.")
@@ -431,7 +460,42 @@ func TestGetProbeRequest(t *testing.T) {
 	}
 }
 
+// TestUpdateProbeRequest tests probe updates - runs after TestCreateProbe
+func TestUpdateProbeRequest(t *testing.T) {
+	requireServer(t)
+
+	if testProbeID == "" {
+		t.Skip("Skipping test because no probe ID is available. TestCreateProbe must run first.")
+	}
+
+	t.Run("successful probe update", func(t *testing.T) {
+		request := ProbeRequest{
+			Name:               testProbeName,
+			Type:               ProbeTypeHTTPProbe,
+			InfrastructureType: InfrastructureTypeKubernetes,
+			KubernetesHTTPProperties: &KubernetesHTTPProbeRequest{
+				ProbeTimeout: "15s",
+				Interval:     "5s",
+				Attempt:      intPtr(2),
+				URL:          "http://localhost:8080/ready",
+				Method:       &Method{Get: &GetMethod{ResponseCode: "200", Criteria: "=="}},
+			},
+		}
+
+		_, err := UpdateProbeRequest(request, projectID, credentials)
+		assert.NoError(t, err)
+	})
+
+	t.Run("validation error - no properties", func(t *testing.T) {
+		_, err := UpdateProbeRequest(ProbeRequest{Name: testProbeName, Type: ProbeTypeHTTPProbe}, projectID, credentials)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "no probe properties provided")
+	})
+}
+
 func TestListProbeRequest(t *testing.T) {
+	requireServer(t)
+
     // This test can run independently since it doesn't depend on specific probe IDs
 	tests := []struct {
 		name       string
@@ -498,6 +562,8 @@ func TestListProbeRequest(t *testing.T) {
 
 // TestGetProbeYAMLRequest tests getting probe YAML
 func TestGetProbeYAMLRequest(t *testing.T) {
+	requireServer(t)
+
 	tests := []struct {
 		name       string
 		projectID  string
@@ -557,6 +623,8 @@ func TestGetProbeYAMLRequest(t *testing.T) {
 
 // TestDeleteProbeRequest tests probe deletion - this should run last
 func TestDeleteProbeRequest(t *testing.T) {
+	requireServer(t)
+
     // Skip this test if no probe was created
     if testProbeID == "" {
         t.Skip("Skipping test because no probe ID is available. TestCreateProbe must run first.")
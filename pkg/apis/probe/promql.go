@@ -0,0 +1,494 @@
+package probe
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// PromQLError is a syntax error in a PromQL query
+type PromQLError struct {
+	// Pos is the 1-based position of the offending character
+	Pos     int
+	Message string
+}
+
+func (e *PromQLError) Error() string {
+	return fmt.Sprintf("invalid PromQL at position %d: %s", e.Pos, e.Message)
+}
+
+var (
+	promAggregations = []string{
+		"avg", "bottomk", "count", "count_values", "group", "limitk", "limit_ratio", "max", "min",
+		"quantile", "stddev", "stdvar", "sum", "topk",
+	}
+	promKeywords = []string{
+		"and", "or", "unless", "atan2", "by", "without", "on", "ignoring", "group_left",
+		"group_right", "bool", "offset",
+	}
+
+	// promPrecedence of the binary operators, ^ is right associative
+	promPrecedence = map[string]int{
+		"or": 1, "and": 2, "unless": 2,
+		"==": 3, "!=": 3, "<": 3, "<=": 3, ">": 3, ">=": 3,
+		"+": 4, "-": 4,
+		"*": 5, "/": 5, "%": 5, "atan2": 5,
+		"^": 6,
+	}
+
+	promDuration = regexp.MustCompile(`^([0-9]+(ms|[smhdwy]))+$`)
+)
+
+// ValidatePromQL checks the syntax of a PromQL query: balanced brackets,
+// operands around operators, function calls and aggregations, label matchers
+// and their regular expressions, range and subquery durations. It does not
+// evaluate the query, so it cannot tell whether metrics exist. Function names
+// are not checked, since newer Prometheus versions keep adding functions.
+func ValidatePromQL(query string) error {
+	tokens, err := lexPromQL(query)
+	if err != nil {
+		return err
+	}
+	p := &promParser{tokens: tokens}
+	if p.peek().kind == promEOF {
+		return &PromQLError{Pos: 1, Message: "query is empty"}
+	}
+	if err := p.expr(0); err != nil {
+		return err
+	}
+	if t := p.peek(); t.kind != promEOF {
+		return p.unexpected(t)
+	}
+	return nil
+}
+
+type promTokenKind int
+
+const (
+	promEOF promTokenKind = iota
+	promIdentifier
+	promNumber
+	promDurationToken
+	promString
+	promOperator
+	promPunctuation
+)
+
+type promToken struct {
+	kind  promTokenKind
+	value string
+	pos   int
+}
+
+func lexPromQL(query string) ([]promToken, error) {
+	var tokens []promToken
+	runes := []rune(query)
+	// Within brackets a colon separates the range and the step of a subquery
+	inBrackets := false
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		start := i
+		switch {
+		case unicode.IsSpace(r):
+			i++
+			continue
+		case r == '#':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+			continue
+		case r == '"' || r == '\'' || r == '`':
+			i++
+			for i < len(runes) && runes[i] != r {
+				if runes[i] == '\\' && r != '`' {
+					i++
+				}
+				i++
+			}
+			if i >= len(runes) {
+				return nil, &PromQLError{Pos: start + 1, Message: "unterminated string"}
+			}
+			i++
+			tokens = append(tokens, promToken{kind: promString, value: string(runes[start:i]), pos: start + 1})
+			continue
+		case unicode.IsDigit(r) || (r == '.' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '.' || runes[i] == '_' ||
+				((runes[i] == '+' || runes[i] == '-') && (runes[i-1] == 'e' || runes[i-1] == 'E') && !strings.HasPrefix(string(runes[start:i]), "0x"))) {
+				i++
+			}
+			value := string(runes[start:i])
+			switch {
+			case promDuration.MatchString(value):
+				tokens = append(tokens, promToken{kind: promDurationToken, value: value, pos: start + 1})
+			case isPromNumber(value):
+				tokens = append(tokens, promToken{kind: promNumber, value: value, pos: start + 1})
+			default:
+				return nil, &PromQLError{Pos: start + 1, Message: fmt.Sprintf("invalid number or duration %q", value)}
+			}
+			continue
+		case unicode.IsLetter(r) || r == '_' || (r == ':' && !inBrackets):
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_' || runes[i] == ':') {
+				i++
+			}
+			tokens = append(tokens, promToken{kind: promIdentifier, value: string(runes[start:i]), pos: start + 1})
+			continue
+		}
+
+		if i+1 < len(runes) {
+			if two := string(runes[i : i+2]); contains([]string{"==", "!=", "<=", ">=", "=~", "!~"}, two) {
+				tokens = append(tokens, promToken{kind: promOperator, value: two, pos: start + 1})
+				i += 2
+				continue
+			}
+		}
+		switch r {
+		case '+', '-', '*', '/', '%', '^', '<', '>', '=':
+			tokens = append(tokens, promToken{kind: promOperator, value: string(r), pos: start + 1})
+		case '(', ')', '{', '}', '[', ']', ',', ':', '@':
+			if r == '[' || r == ']' {
+				inBrackets = r == '['
+			}
+			tokens = append(tokens, promToken{kind: promPunctuation, value: string(r), pos: start + 1})
+		default:
+			return nil, &PromQLError{Pos: start + 1, Message: fmt.Sprintf("unexpected character %q", r)}
+		}
+		i++
+	}
+	return append(tokens, promToken{kind: promEOF, pos: len(runes) + 1}), nil
+}
+
+func isPromNumber(value string) bool {
+	if strings.HasPrefix(value, "0x") || strings.HasPrefix(value, "0X") {
+		_, err := strconv.ParseInt(value[2:], 16, 64)
+		return err == nil
+	}
+	_, err := strconv.ParseFloat(value, 64)
+	return err == nil
+}
+
+type promParser struct {
+	tokens []promToken
+	pos    int
+}
+
+func (p *promParser) peek() promToken {
+	return p.tokens[p.pos]
+}
+
+func (p *promParser) next() promToken {
+	t := p.tokens[p.pos]
+	if t.kind != promEOF {
+		p.pos++
+	}
+	return t
+}
+
+// accept consumes the next token if it has the given value
+func (p *promParser) accept(value string) bool {
+	if t := p.peek(); t.kind != promEOF && t.kind != promString && t.value == value {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *promParser) expect(value string) error {
+	if !p.accept(value) {
+		t := p.peek()
+		if t.kind == promEOF {
+			return &PromQLError{Pos: t.pos, Message: fmt.Sprintf("unexpected end of query, expected %q", value)}
+		}
+		return &PromQLError{Pos: t.pos, Message: fmt.Sprintf("unexpected %q, expected %q", t.value, value)}
+	}
+	return nil
+}
+
+func (p *promParser) unexpected(t promToken) error {
+	if t.kind == promEOF {
+		return &PromQLError{Pos: t.pos, Message: "unexpected end of query"}
+	}
+	return &PromQLError{Pos: t.pos, Message: fmt.Sprintf("unexpected %q", t.value)}
+}
+
+// binaryOperator returns the binary operator starting at the next token, if any
+func (p *promParser) binaryOperator() (string, bool) {
+	t := p.peek()
+	if t.kind != promOperator && t.kind != promIdentifier {
+		return "", false
+	}
+	_, ok := promPrecedence[t.value]
+	return t.value, ok
+}
+
+// expr parses a binary expression whose operators bind at least as tightly as minPrecedence
+func (p *promParser) expr(minPrecedence int) error {
+	if err := p.unary(); err != nil {
+		return err
+	}
+
+	for {
+		op, ok := p.binaryOperator()
+		if !ok || promPrecedence[op] < minPrecedence {
+			return nil
+		}
+		p.next()
+
+		if promPrecedence[op] == 3 {
+			p.accept("bool")
+		}
+		if err := p.vectorMatching(); err != nil {
+			return err
+		}
+
+		next := promPrecedence[op] + 1
+		if op == "^" {
+			next = promPrecedence[op]
+		}
+		if err := p.expr(next); err != nil {
+			return err
+		}
+	}
+}
+
+// vectorMatching parses the on, ignoring, group_left and group_right modifiers of a binary operator
+func (p *promParser) vectorMatching() error {
+	if !p.accept("on") && !p.accept("ignoring") {
+		return nil
+	}
+	if err := p.labels(); err != nil {
+		return err
+	}
+	if p.accept("group_left") || p.accept("group_right") {
+		if p.peek().value == "(" && p.peek().kind == promPunctuation {
+			return p.labels()
+		}
+	}
+	return nil
+}
+
+func (p *promParser) unary() error {
+	for p.accept("-") || p.accept("+") {
+	}
+	if err := p.primary(); err != nil {
+		return err
+	}
+	return p.postfix()
+}
+
+// postfix parses the range, subquery, offset and @ modifiers of an expression
+func (p *promParser) postfix() error {
+	for {
+		switch {
+		case p.accept("["):
+			if err := p.duration(); err != nil {
+				return err
+			}
+			if p.accept(":") && p.peek().kind == promDurationToken {
+				p.next()
+			}
+			if err := p.expect("]"); err != nil {
+				return err
+			}
+		case p.accept("offset"):
+			p.accept("-")
+			if err := p.duration(); err != nil {
+				return err
+			}
+		case p.accept("@"):
+			t := p.next()
+			switch {
+			case t.kind == promNumber:
+			case t.value == "start" || t.value == "end":
+				if err := p.expect("("); err != nil {
+					return err
+				}
+				if err := p.expect(")"); err != nil {
+					return err
+				}
+			default:
+				return p.unexpected(t)
+			}
+		default:
+			return nil
+		}
+	}
+}
+
+func (p *promParser) duration() error {
+	t := p.next()
+	if t.kind != promDurationToken {
+		if t.kind == promNumber {
+			return &PromQLError{Pos: t.pos, Message: fmt.Sprintf("missing unit in duration %q, expected e.g. 5m", t.value)}
+		}
+		return &PromQLError{Pos: t.pos, Message: fmt.Sprintf("expected a duration such as 5m, got %q", t.value)}
+	}
+	return nil
+}
+
+func (p *promParser) primary() error {
+	t := p.next()
+	switch t.kind {
+	case promNumber, promString:
+		return nil
+	case promIdentifier:
+		return p.identifier(t)
+	case promPunctuation:
+		switch t.value {
+		case "(":
+			if err := p.expr(0); err != nil {
+				return err
+			}
+			return p.expect(")")
+		case "{":
+			return p.matchers(t, false)
+		}
+	}
+	return p.unexpected(t)
+}
+
+func (p *promParser) identifier(t promToken) error {
+	name := t.value
+	switch {
+	case name == "Inf" || name == "NaN" || name == "inf" || name == "nan":
+		return nil
+	case contains(promKeywords, name):
+		return p.unexpected(t)
+	case contains(promAggregations, name):
+		return p.aggregation(t)
+	}
+
+	next := p.peek()
+	if next.kind == promPunctuation && next.value == "(" {
+		p.next()
+		return p.arguments()
+	}
+	if next.kind == promPunctuation && next.value == "{" {
+		return p.matchers(p.next(), true)
+	}
+	return nil
+}
+
+func (p *promParser) aggregation(t promToken) error {
+	grouped := false
+	if p.accept("by") || p.accept("without") {
+		if err := p.labels(); err != nil {
+			return err
+		}
+		grouped = true
+	}
+	if err := p.expect("("); err != nil {
+		return err
+	}
+	if p.accept(")") {
+		return &PromQLError{Pos: t.pos, Message: fmt.Sprintf("%s needs an argument", t.value)}
+	}
+	if err := p.argumentList(); err != nil {
+		return err
+	}
+	if !grouped && (p.accept("by") || p.accept("without")) {
+		return p.labels()
+	}
+	return nil
+}
+
+// arguments parses the arguments of a function call after the opening parenthesis
+func (p *promParser) arguments() error {
+	if p.accept(")") {
+		return nil
+	}
+	return p.argumentList()
+}
+
+func (p *promParser) argumentList() error {
+	for {
+		if err := p.expr(0); err != nil {
+			return err
+		}
+		if p.accept(")") {
+			return nil
+		}
+		if !p.accept(",") {
+			t := p.peek()
+			if t.kind == promEOF {
+				return &PromQLError{Pos: t.pos, Message: `unexpected end of query, expected "," or ")"`}
+			}
+			return &PromQLError{Pos: t.pos, Message: fmt.Sprintf(`unexpected %q, expected "," or ")"`, t.value)}
+		}
+	}
+}
+
+// labels parses a parenthesized list of label names
+func (p *promParser) labels() error {
+	if err := p.expect("("); err != nil {
+		return err
+	}
+	for !p.accept(")") {
+		t := p.next()
+		if t.kind != promIdentifier && t.kind != promString {
+			return p.unexpected(t)
+		}
+		if !p.accept(",") {
+			if err := p.expect(")"); err != nil {
+				return err
+			}
+			return nil
+		}
+	}
+	return nil
+}
+
+// matchers parses the label matchers of a selector after the opening brace.
+// Without a metric name before the brace, at least one matcher is required.
+func (p *promParser) matchers(open promToken, named bool) error {
+	count := 0
+	for !p.accept("}") {
+		t := p.next()
+		switch t.kind {
+		case promString:
+			// A quoted metric name
+		case promIdentifier:
+			op := p.next()
+			if op.kind != promOperator || !contains([]string{"=", "!=", "=~", "!~"}, op.value) {
+				return &PromQLError{Pos: op.pos, Message: fmt.Sprintf("expected a label matching operator after %s, got %q", t.value, op.value)}
+			}
+			value := p.next()
+			if value.kind != promString {
+				return &PromQLError{Pos: value.pos, Message: fmt.Sprintf("expected a quoted label value, got %q", value.value)}
+			}
+			if op.value == "=~" || op.value == "!~" {
+				pattern, err := unquotePromString(value.value)
+				if err == nil {
+					_, err = regexp.Compile("^(?:" + pattern + ")$")
+				}
+				if err != nil {
+					return &PromQLError{Pos: value.pos, Message: fmt.Sprintf("invalid regular expression %s: %v", value.value, err)}
+				}
+			}
+		default:
+			return p.unexpected(t)
+		}
+		count++
+
+		if !p.accept(",") {
+			if err := p.expect("}"); err != nil {
+				return err
+			}
+			break
+		}
+	}
+	if count == 0 && !named {
+		return &PromQLError{Pos: open.pos, Message: "selector needs a metric name or at least one label matcher"}
+	}
+	return nil
+}
+
+// unquotePromString decodes a PromQL string literal, which may use single quotes
+func unquotePromString(literal string) (string, error) {
+	if strings.HasPrefix(literal, "'") {
+		inner := strings.ReplaceAll(literal[1:len(literal)-1], `\'`, "'")
+		literal = `"` + strings.ReplaceAll(inner, `"`, `\"`) + `"`
+	}
+	return strconv.Unquote(literal)
+}
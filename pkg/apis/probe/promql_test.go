@@ -0,0 +1,56 @@
+package probe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePromQL(t *testing.T) {
+	valid := []string{
+		`up`,
+		`up{job="checkout", instance!~'10\\..*'}`,
+		`{__name__="up"}`,
+		`sum(rate(http_requests_total{code=~"5.."}[5m])) / sum(rate(http_requests_total[5m]))`,
+		`histogram_quantile(0.99, sum by (le) (rate(latency_bucket[1m])))`,
+		`sum(rate(http_requests_total[5m])) without (instance)`,
+		`topk(3, node_load1)`,
+		`avg_over_time(up[1h:30s]) offset 5m`,
+		`max_over_time(deriv(rate(cpu[5m])[30m:])[1h:]) > bool 0.5`,
+		`requests * on(instance) group_left(version) build_info`,
+		`2 ^ 3 ^ 2 - -1`,
+		`vector(1) and on() absent(up{job="checkout"})`,
+		`time() - process_start_time_seconds @ 1609746000 # uptime`,
+		`node_memory_Active_bytes / 1e9 > 0x10`,
+		`up == Inf or up != NaN`,
+		`first_over_time(up[5m])`, // functions of newer Prometheus versions are accepted
+	}
+	for _, query := range valid {
+		assert.NoError(t, ValidatePromQL(query), query)
+	}
+
+	invalid := []struct {
+		query string
+		err   string
+	}{
+		{query: ``, err: "invalid PromQL at position 1: query is empty"},
+		{query: `sum(rate(x[5m])`, err: `invalid PromQL at position 16: unexpected end of query, expected "," or ")"`},
+		{query: `rate(x[5m]))`, err: `invalid PromQL at position 12: unexpected ")"`},
+		{query: `up >`, err: "invalid PromQL at position 5: unexpected end of query"},
+		{query: `rate(x[5])`, err: `invalid PromQL at position 8: missing unit in duration "5", expected e.g. 5m`},
+		{query: `rates(x[5m]`, err: `invalid PromQL at position 12: unexpected end of query, expected "," or ")"`},
+		{query: `up{job="a}`, err: "invalid PromQL at position 8: unterminated string"},
+		{query: `up{job=a}`, err: `invalid PromQL at position 8: expected a quoted label value, got "a"`},
+		{query: `up{job=~"(a"}`, err: "invalid PromQL at position 9: invalid regular expression"},
+		{query: `{}`, err: "invalid PromQL at position 1: selector needs a metric name or at least one label matcher"},
+		{query: `sum()`, err: "invalid PromQL at position 1: sum needs an argument"},
+		{query: `up and`, err: "invalid PromQL at position 7: unexpected end of query"},
+		{query: `up $ 1`, err: `invalid PromQL at position 4: unexpected character '$'`},
+	}
+	for _, tt := range invalid {
+		err := ValidatePromQL(tt.query)
+		if assert.Error(t, err, tt.query) {
+			assert.Contains(t, err.Error(), tt.err, tt.query)
+		}
+	}
+}
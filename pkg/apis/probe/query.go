@@ -1,14 +1,114 @@
 package probe
 
 const (
-	ListProbeQuery = `query ListProbes($projectID: ID!, $probeNames: [ID!], $filter: ProbeFilterInput) {
-		listProbes(projectID: $projectID, probeNames: $probeNames, filter: $filter) {
+	ListProbeQuery = `query ListProbes($projectID: ID!, $infrastructureType: InfrastructureType, $probeNames: [ID!], $filter: ProbeFilterInput) {
+		listProbes(projectID: $projectID, infrastructureType: $infrastructureType, probeNames: $probeNames, filter: $filter) {
 		  name
+		  description
 		  type
+		  infrastructureType
+		  tags
 		  createdAt
 		  createdBy{
 			username
 		  }
+		  updatedAt
+		}
+	  }
+	`
+	ListProbeDetailsQuery = `query ListProbeDetails($projectID: ID!, $probeNames: [ID!]) {
+		listProbes(projectID: $projectID, probeNames: $probeNames) {
+		  name
+		  description
+		  type
+		  infrastructureType
+		  kubernetesHTTPProperties{
+			probeTimeout
+			interval
+			retry
+			attempt
+			probePollingInterval
+			initialDelay
+			evaluationTimeout
+			stopOnFailure
+			url
+			insecureSkipVerify
+			method {
+				get {
+					responseCode
+					criteria
+				}
+				post {
+					body
+					bodyPath
+					contentType
+					responseCode
+					criteria
+				}
+			}
+		  }
+		  kubernetesCMDProperties{
+			probeTimeout
+			interval
+			retry
+			attempt
+			probePollingInterval
+			initialDelay
+			evaluationTimeout
+			stopOnFailure
+			command
+			comparator {
+				type
+				criteria
+				value
+			}
+			source
+		  }
+		  k8sProperties {
+			probeTimeout
+			interval
+			retry
+			attempt
+			probePollingInterval
+			initialDelay
+			evaluationTimeout
+			stopOnFailure
+			group
+			version
+			resource
+			namespace
+			resourceNames
+			fieldSelector
+			labelSelector
+			operation
+		  }
+		  promProperties {
+			probeTimeout
+			interval
+			retry
+			attempt
+			probePollingInterval
+			initialDelay
+			evaluationTimeout
+			stopOnFailure
+			endpoint
+			query
+			queryPath
+			comparator {
+				type
+				criteria
+				value
+			}
+		  }
+		  createdAt
+		  createdBy{
+			username
+		  }
+		  updatedAt
+		  updatedBy{
+			username
+		  }
+		  tags
 		}
 	  }
 	`
@@ -36,6 +136,7 @@ const (
 				}
 				post {
 					body
+					bodyPath
 					contentType
 					responseCode
 					criteria
@@ -72,6 +173,10 @@ const (
 			version
 			resource
 			namespace
+			resourceNames
+			fieldSelector
+			labelSelector
+			operation
 		  }
 		  promProperties {
 			probeTimeout
@@ -84,6 +189,12 @@ const (
 			stopOnFailure
 			endpoint
 			query
+			queryPath
+			comparator {
+				type
+				criteria
+				value
+			}
 		  }
 		  createdAt
 		  createdBy{
@@ -106,56 +217,152 @@ const (
 		deleteProbe(probeName: $probeName, projectID: $projectID)
 	  }
 	`
-	createProbeMutation = `mutation addProbe($projectID: ID!, $request: ProbeRequest!) {
-		addProbe(projectID: $projectID, request: $request) {
+	GetProbeReferenceQuery = `query getProbeReference($projectID: ID!, $probeName: ID!) {
+		getProbeReference(projectID: $projectID, probeName: $probeName) {
+		  projectID
+		  name
+		  totalRuns
+		  recentExecutions {
+			faultName
+			mode
+			executionHistory {
+			  mode
+			  faultName
+			  status {
+				verdict
+				description
+			  }
+			  executedByExperiment {
+				experimentID
+				experimentName
+				updatedAt
+				updatedBy {
+				  username
+				}
+			  }
+			}
+		  }
+		}
+	  }
+	`
+	GetProbesInExperimentRunQuery = `query getProbesInExperimentRun($projectID: ID!, $experimentRunID: String!, $faultName: String!) {
+		getProbesInExperimentRun(projectID: $projectID, experimentRunID: $experimentRunID, faultName: $faultName) {
+		  probe {
 			name
-			description
 			type
 			infrastructureType
 			tags
-			kubernetesHTTPProperties {
-				probeTimeout
-				interval
-				url
-				method {
-					get {
-						responseCode
-						criteria
-					}
-					post {
-						body
-						contentType
-						responseCode
-						criteria
-					}
+		  }
+		  mode
+		  status {
+			verdict
+			description
+		  }
+		}
+	  }
+	`
+	ValidateUniqueProbeQuery = `query validateUniqueProbe($projectID: ID!, $probeName: ID!) {
+		validateUniqueProbe(projectID: $projectID, probeName: $probeName)
+	  }
+	`
+	UpdateProbeQuery = `mutation updateProbe($request: ProbeRequest!, $projectID: ID!) {
+		updateProbe(request: $request, projectID: $projectID)
+	  }
+	`
+	createProbeMutation = `mutation addProbe($projectID: ID!, $request: ProbeRequest!) {
+		addProbe(projectID: $projectID, request: $request) {
+		  name
+		  description
+		  type
+		  infrastructureType
+		  kubernetesHTTPProperties{
+			probeTimeout
+			interval
+			retry
+			attempt
+			probePollingInterval
+			initialDelay
+			evaluationTimeout
+			stopOnFailure
+			url
+			insecureSkipVerify
+			method {
+				get {
+					responseCode
+					criteria
 				}
-				insecureSkipVerify
-			}
-			kubernetesCMDProperties {
-				probeTimeout
-				interval
-				command
-				comparator {
-					type
+				post {
+					body
+					bodyPath
+					contentType
+					responseCode
 					criteria
-					value
 				}
 			}
-			k8sProperties {
-				probeTimeout
-				interval
-				retry
-				group
-				version
-				resource
-				namespace
+		  }
+		  kubernetesCMDProperties{
+			probeTimeout
+			interval
+			retry
+			attempt
+			probePollingInterval
+			initialDelay
+			evaluationTimeout
+			stopOnFailure
+			command
+			comparator {
+				type
+				criteria
+				value
 			}
-			promProperties {
-				probeTimeout
-				interval
-				endpoint
-				query
+			source
+		  }
+		  k8sProperties {
+			probeTimeout
+			interval
+			retry
+			attempt
+			probePollingInterval
+			initialDelay
+			evaluationTimeout
+			stopOnFailure
+			group
+			version
+			resource
+			namespace
+			resourceNames
+			fieldSelector
+			labelSelector
+			operation
+		  }
+		  promProperties {
+			probeTimeout
+			interval
+			retry
+			attempt
+			probePollingInterval
+			initialDelay
+			evaluationTimeout
+			stopOnFailure
+			endpoint
+			query
+			queryPath
+			comparator {
+				type
+				criteria
+				value
 			}
+		  }
+		  createdAt
+		  createdBy{
+			username
+		  }
+		  updatedAt
+		  updatedBy{
+			username
+		  }
+		  tags
 		}
-	}`
+	  }
+	`
 )
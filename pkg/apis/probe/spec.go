@@ -0,0 +1,87 @@
+package probe
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ProbeSpec is the definition of a probe in a given mode as it appears in the
+// probe list of a ChaosEngine experiment. ChaosCenter generates it from the
+// probe properties; GetProbeYAML returns it.
+type ProbeSpec struct {
+	Name string    `json:"name"`
+	Type ProbeType `json:"type"`
+
+	HTTPProbeInputs *HTTPProbeInputs `json:"httpProbe/inputs,omitempty"`
+	CMDProbeInputs  *CMDProbeInputs  `json:"cmdProbe/inputs,omitempty"`
+	PROMProbeInputs *PROMProbeInputs `json:"promProbe/inputs,omitempty"`
+	K8SProbeInputs  *K8SProbeInputs  `json:"k8sProbe/inputs,omitempty"`
+
+	RunProperties RunProperties `json:"runProperties"`
+	Mode          ProbeMode     `json:"mode"`
+
+	// Raw is the definition as returned by ChaosCenter, empty when the spec was built otherwise
+	Raw string `json:"-"`
+}
+
+// HTTPProbeInputs are the inputs of an HTTP probe in a ChaosEngine
+type HTTPProbeInputs struct {
+	URL                string `json:"url"`
+	Method             Method `json:"method"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify,omitempty"`
+}
+
+// CMDProbeInputs are the inputs of a CMD probe in a ChaosEngine
+type CMDProbeInputs struct {
+	Command    string          `json:"command"`
+	Comparator ComparatorInput `json:"comparator"`
+
+	// Source is the pod running the command, see the source of KubernetesCMDProbeRequest
+	Source json.RawMessage `json:"source,omitempty"`
+}
+
+// PROMProbeInputs are the inputs of a PROM probe in a ChaosEngine
+type PROMProbeInputs struct {
+	Endpoint   string          `json:"endpoint"`
+	Query      string          `json:"query,omitempty"`
+	QueryPath  string          `json:"queryPath,omitempty"`
+	Comparator ComparatorInput `json:"comparator"`
+}
+
+// K8SProbeInputs are the inputs of a K8s probe in a ChaosEngine
+type K8SProbeInputs struct {
+	Group         string `json:"group,omitempty"`
+	Version       string `json:"version"`
+	Resource      string `json:"resource"`
+	ResourceNames string `json:"resourceNames,omitempty"`
+	Namespace     string `json:"namespace,omitempty"`
+	FieldSelector string `json:"fieldSelector,omitempty"`
+	LabelSelector string `json:"labelSelector,omitempty"`
+	Operation     string `json:"operation"`
+}
+
+// RunProperties are the timing settings shared by all probe types
+type RunProperties struct {
+	ProbeTimeout         string `json:"probeTimeout"`
+	Interval             string `json:"interval"`
+	Retry                int    `json:"retry,omitempty"`
+	Attempt              int    `json:"attempt,omitempty"`
+	ProbePollingInterval string `json:"probePollingInterval,omitempty"`
+	InitialDelay         string `json:"initialDelay,omitempty"`
+	EvaluationTimeout    string `json:"evaluationTimeout,omitempty"`
+	StopOnFailure        bool   `json:"stopOnFailure,omitempty"`
+}
+
+// ParseProbeSpec decodes a probe definition returned by GetProbeYAML. The
+// definition is kept in Raw.
+func ParseProbeSpec(raw string) (ProbeSpec, error) {
+	var spec ProbeSpec
+	if err := json.Unmarshal([]byte(raw), &spec); err != nil {
+		return ProbeSpec{}, fmt.Errorf("invalid probe definition: %v", err)
+	}
+	if spec.Name == "" {
+		return ProbeSpec{}, fmt.Errorf("invalid probe definition: name is missing")
+	}
+	spec.Raw = raw
+	return spec, nil
+}
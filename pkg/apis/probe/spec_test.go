@@ -0,0 +1,38 @@
+package probe
+
+import (
+	"testing"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProbeSpec(t *testing.T) {
+	raw := `{"name":"disk","type":"cmdProbe","cmdProbe/inputs":{"command":"df","comparator":{"type":"int","criteria":"<","value":"90"},"source":{"image":"busybox"}},"runProperties":{"probeTimeout":"5s","interval":"2s","attempt":1,"stopOnFailure":true},"mode":"Continuous"}`
+
+	spec, err := ParseProbeSpec(raw)
+	require.NoError(t, err)
+	assert.Equal(t, "disk", spec.Name)
+	assert.Equal(t, ProbeTypeCMDProbe, spec.Type)
+	assert.Equal(t, ProbeModeContinuous, spec.Mode)
+	require.NotNil(t, spec.CMDProbeInputs)
+	assert.Equal(t, ComparatorInput{Type: "int", Criteria: "<", Value: "90"}, spec.CMDProbeInputs.Comparator)
+	assert.JSONEq(t, `{"image":"busybox"}`, string(spec.CMDProbeInputs.Source))
+	assert.Equal(t, RunProperties{ProbeTimeout: "5s", Interval: "2s", Attempt: 1, StopOnFailure: true}, spec.RunProperties)
+	assert.Equal(t, raw, spec.Raw)
+
+	_, err = ParseProbeSpec("")
+	assert.Error(t, err)
+}
+
+func TestGetProbeYAMLValidatesMode(t *testing.T) {
+	assert.True(t, ProbeModeOnChaos.IsValid())
+	assert.False(t, ProbeMode("sot").IsValid())
+
+	_, err := GetProbeYAML("project", "disk", "sot", types.Credentials{Endpoint: "http://unused"})
+	assert.EqualError(t, err, `invalid probe mode "sot", expected one of [SOT EOT Edge Continuous OnChaos]`)
+
+	_, err = GetProbeYAML("project", "", ProbeModeSOT, types.Credentials{Endpoint: "http://unused"})
+	assert.EqualError(t, err, "probe name cannot be empty")
+}
@@ -50,6 +50,26 @@ type GetProbeYAMLResponseData struct {
 	GetProbeYAML string `json:"getProbeYAML"`
 }
 
+type AddProbeResponseData struct {
+	AddProbe model.Probe `json:"addProbe"`
+}
+
+type ValidateUniqueProbeData struct {
+	ValidateUniqueProbe bool `json:"validateUniqueProbe"`
+}
+
+type UpdateProbeResponseData struct {
+	UpdateProbe string `json:"updateProbe"`
+}
+
+type GetProbeReferenceData struct {
+	GetProbeReference model.GetProbeReferenceResponse `json:"getProbeReference"`
+}
+
+type GetProbesInExperimentRunData struct {
+	Probes []*model.GetProbesInExperimentRunResponse `json:"getProbesInExperimentRun"`
+}
+
 // ProbeType defines the type of probe.
 type ProbeType string
 
@@ -68,23 +88,42 @@ const (
 	// Add other infrastructure types if necessary
 )
 
+// ProbeMode defines when a probe runs relative to the chaos injection of a fault.
+// It is the mode of the ChaosCenter API, so values can be passed either way.
+type ProbeMode = model.Mode
+
+const (
+	ProbeModeSOT        = model.ModeSot        // Start of test, before the chaos injection
+	ProbeModeEOT        = model.ModeEot        // End of test, after the chaos injection
+	ProbeModeEdge       = model.ModeEdge       // Both before and after the chaos injection
+	ProbeModeContinuous = model.ModeContinuous // Throughout the fault
+	ProbeModeOnChaos    = model.ModeOnChaos    // Only while chaos is injected
+)
+
+// AllProbeModes lists the modes accepted by ChaosCenter
+var AllProbeModes = model.AllMode
+
 // KubernetesHTTPProbeRequest defines properties for Kubernetes HTTP probes.
-// Aligned with UI example for addKubernetesHTTPProbe variables.request.kubernetesHTTPProperties
+// This maps to KubernetesHTTPProbeRequest in the GraphQL schema.
 type KubernetesHTTPProbeRequest struct {
-	ProbeTimeout       string  `json:"probeTimeout"`
-	Interval           string  `json:"interval"`
-	Attempt            *int    `json:"attempt,omitempty"` // Added from UI example
-	URL                string  `json:"url"`
-	Method             *Method `json:"method"`
-	InsecureSkipVerify *bool   `json:"insecureSkipVerify,omitempty"` // Present in GQL schema, can be set
-	// Removed: Retry, PollingInterval, InitialDelay, EvaluationTimeout, StopOnFailure (not in UI addProbe input for properties)
+	ProbeTimeout         string  `json:"probeTimeout"`
+	Interval             string  `json:"interval"`
+	Retry                *int    `json:"retry,omitempty"`
+	Attempt              *int    `json:"attempt,omitempty"`
+	ProbePollingInterval *string `json:"probePollingInterval,omitempty"`
+	InitialDelay         *string `json:"initialDelay,omitempty"`
+	EvaluationTimeout    *string `json:"evaluationTimeout,omitempty"`
+	StopOnFailure        *bool   `json:"stopOnFailure,omitempty"`
+	URL                  string  `json:"url"`
+	Method               *Method `json:"method"`
+	InsecureSkipVerify   *bool   `json:"insecureSkipVerify,omitempty"`
 }
 
 // Method defines the HTTP method and its properties.
 // This maps to HTTPProbeInputs in the GraphQL schema.
 type Method struct {
 	Get  *GetMethod  `json:"get,omitempty"`
-	Post *PostMethod `json:"post,omitempty"` // Kept for completeness, though UI example only shows GET
+	Post *PostMethod `json:"post,omitempty"`
 }
 
 type GetMethod struct { // Maps to HTTPGetInput
@@ -94,51 +133,69 @@ type GetMethod struct { // Maps to HTTPGetInput
 
 type PostMethod struct { // Maps to HTTPPostInput
 	Body         *string `json:"body,omitempty"`
+	BodyPath     *string `json:"bodyPath,omitempty"`
 	ContentType  *string `json:"contentType,omitempty"`
 	ResponseCode string  `json:"responseCode"`
 	Criteria     string  `json:"criteria"`
 }
 
-// ComparatorInput represents the comparator input for CMD probes
+// ComparatorInput represents the comparator input for CMD and PROM probes
 type ComparatorInput struct {
-	Type     string `json:"type"`     // e.g., "Contains", "Equal", "NotEqual"
-	Criteria string `json:"criteria"` // This field is required by the GraphQL schema
+	Type     string `json:"type"`     // int, float or string
+	Criteria string `json:"criteria"` // e.g. "==", ">=", "contains"
 	Value    string `json:"value"`    // The value to compare against
 }
 
-// Update KubernetesCMDProbeRequest to use the corrected ComparatorInput
+// KubernetesCMDProbeRequest defines properties for Kubernetes CMD probes.
 type KubernetesCMDProbeRequest struct {
-	Command      string           `json:"command"`
-	Comparator   *ComparatorInput `json:"comparator,omitempty"`
-	Source       *string          `json:"source,omitempty"`
-	ProbeTimeout string           `json:"probeTimeout"`
-	Interval     string           `json:"interval"`
-	Attempt      *int             `json:"attempt,omitempty"`
+	Command              string           `json:"command"`
+	Comparator           *ComparatorInput `json:"comparator,omitempty"`
+	Source               *string          `json:"source,omitempty"`
+	ProbeTimeout         string           `json:"probeTimeout"`
+	Interval             string           `json:"interval"`
+	Retry                *int             `json:"retry,omitempty"`
+	Attempt              *int             `json:"attempt,omitempty"`
+	ProbePollingInterval *string          `json:"probePollingInterval,omitempty"`
+	InitialDelay         *string          `json:"initialDelay,omitempty"`
+	EvaluationTimeout    *string          `json:"evaluationTimeout,omitempty"`
+	StopOnFailure        *bool            `json:"stopOnFailure,omitempty"`
 }
 
+// K8SProbeRequest defines properties for K8s probes.
 type K8SProbeRequest struct {
-	ProbeTimeout  string  `json:"probeTimeout"`
-	Interval      string  `json:"interval"`
-	Attempt       *int    `json:"attempt,omitempty"` 
-	Group         *string `json:"group,omitempty"` 
-	Version       string  `json:"version"`
-	Resource      string  `json:"resource"`
-	Namespace     string  `json:"namespace"`
-	ResourceNames *string `json:"resourceNames,omitempty"` 
-	FieldSelector *string `json:"fieldSelector,omitempty"` 
-	LabelSelector *string `json:"labelSelector,omitempty"` 
-	Operation     string  `json:"operation"`             
-	}
-
-// PROMProbeRequest defines properties for Prometheus probes.
+	ProbeTimeout         string  `json:"probeTimeout"`
+	Interval             string  `json:"interval"`
+	Retry                *int    `json:"retry,omitempty"`
+	Attempt              *int    `json:"attempt,omitempty"`
+	ProbePollingInterval *string `json:"probePollingInterval,omitempty"`
+	InitialDelay         *string `json:"initialDelay,omitempty"`
+	EvaluationTimeout    *string `json:"evaluationTimeout,omitempty"`
+	StopOnFailure        *bool   `json:"stopOnFailure,omitempty"`
+	Group                *string `json:"group,omitempty"`
+	Version              string  `json:"version"`
+	Resource             string  `json:"resource"`
+	Namespace            string  `json:"namespace"`
+	ResourceNames        *string `json:"resourceNames,omitempty"`
+	FieldSelector        *string `json:"fieldSelector,omitempty"`
+	LabelSelector        *string `json:"labelSelector,omitempty"`
+	Operation            string  `json:"operation"`
+}
+
+// PROMProbeRequest defines properties for Prometheus probes. The query result
+// is compared as a float, so the comparator type is float.
 type PROMProbeRequest struct {
-	Endpoint     string  `json:"endpoint"`
-	Query        string  `json:"query"`
-	Comparator   string  `json:"comparator"`
-	Value        string  `json:"value"`
-	ProbeTimeout string  `json:"probeTimeout"`
-	Interval     string  `json:"interval"`
-	Attempt      *int    `json:"attempt,omitempty"`
+	Endpoint             string           `json:"endpoint"`
+	Query                string           `json:"query,omitempty"`
+	QueryPath            *string          `json:"queryPath,omitempty"`
+	Comparator           *ComparatorInput `json:"comparator,omitempty"`
+	ProbeTimeout         string           `json:"probeTimeout"`
+	Interval             string           `json:"interval"`
+	Retry                *int             `json:"retry,omitempty"`
+	Attempt              *int             `json:"attempt,omitempty"`
+	ProbePollingInterval *string          `json:"probePollingInterval,omitempty"`
+	InitialDelay         *string          `json:"initialDelay,omitempty"`
+	EvaluationTimeout    *string          `json:"evaluationTimeout,omitempty"`
+	StopOnFailure        *bool            `json:"stopOnFailure,omitempty"`
 }
 
 type ProbeRequest struct {
@@ -162,6 +219,9 @@ type AddProbeResponse struct {
 	Data map[string]interface{} `json:"data,omitempty"` // This is the actual response
 }
 
+// Probe is the probe returned by CreateProbeRequest
+//
+// Deprecated: AddProbe returns models.Probe, convert it with RequestFromModel to modify and resubmit it.
 type Probe struct {
 	Name                     string                       `json:"name"`
 	Description              string                       `json:"description"` // Not a pointer in the response
@@ -0,0 +1,301 @@
+package probe
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Comparator types of CMD probes, PROM probes always compare floats
+const (
+	ComparatorTypeInt    = "int"
+	ComparatorTypeFloat  = "float"
+	ComparatorTypeString = "string"
+)
+
+// K8s probe operations
+const (
+	K8SOperationPresent = "present"
+	K8SOperationAbsent  = "absent"
+	K8SOperationCreate  = "create"
+	K8SOperationDelete  = "delete"
+)
+
+var (
+	numericCriteria = []string{"==", "!=", "<", ">", "<=", ">=", "oneOf", "between"}
+	stringCriteria  = []string{"equal", "notEqual", "contains", "matches", "notMatches", "oneOf"}
+	httpCriteria    = []string{"==", "!=", "oneOf"}
+	k8sOperations   = []string{K8SOperationPresent, K8SOperationAbsent, K8SOperationCreate, K8SOperationDelete}
+
+	comparatorCriteria = map[string][]string{
+		ComparatorTypeInt:    numericCriteria,
+		ComparatorTypeFloat:  numericCriteria,
+		ComparatorTypeString: stringCriteria,
+	}
+)
+
+// FieldError is a validation error of a single probe request field
+type FieldError struct {
+	// Field is the JSON path of the field, e.g. kubernetesHTTPProperties.probeTimeout
+	Field   string
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// Validate checks that the request has a single properties block matching its
+// type and that the values in it are accepted by the probe runtime: durations,
+// HTTP criteria and response codes, comparator types, criteria and values,
+// and K8s operations. All invalid fields are reported, joined in one error.
+func (r ProbeRequest) Validate() error {
+	if err := validateProbeRequest(r); err != nil {
+		return err
+	}
+
+	v := &validator{}
+	if r.Name == "" {
+		v.add("name", "is required")
+	}
+	if r.InfrastructureType != "" && r.InfrastructureType != InfrastructureTypeKubernetes {
+		v.add("infrastructureType", "unsupported infrastructure type %q", r.InfrastructureType)
+	}
+
+	switch r.Type {
+	case ProbeTypeHTTPProbe:
+		v.http("kubernetesHTTPProperties", r.KubernetesHTTPProperties)
+	case ProbeTypeCMDProbe:
+		v.cmd("kubernetesCMDProperties", r.KubernetesCMDProperties)
+	case ProbeTypeK8SProbe:
+		v.k8s("k8sProperties", r.K8SProperties)
+	case ProbeTypePROMProbe:
+		v.prom("promProperties", r.PROMProperties)
+	}
+	return errors.Join(v.errs...)
+}
+
+type validator struct {
+	errs []error
+}
+
+func (v *validator) add(field string, format string, args ...interface{}) {
+	v.errs = append(v.errs, &FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) run(prefix string, timeout, interval string, attempt *int) {
+	v.duration(prefix+".probeTimeout", timeout)
+	v.duration(prefix+".interval", interval)
+	if attempt != nil && *attempt < 1 {
+		v.add(prefix+".attempt", "must be at least 1, got %d", *attempt)
+	}
+}
+
+func (v *validator) duration(field, value string) {
+	if value == "" {
+		v.add(field, "is required")
+		return
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		v.add(field, "invalid duration %q, expected a Go duration such as 5s or 1m30s", value)
+		return
+	}
+	if d <= 0 {
+		v.add(field, "must be positive, got %s", value)
+	}
+}
+
+func (v *validator) http(prefix string, p *KubernetesHTTPProbeRequest) {
+	v.run(prefix, p.ProbeTimeout, p.Interval, p.Attempt)
+	if p.URL == "" {
+		v.add(prefix+".url", "is required")
+	}
+
+	switch {
+	case p.Method == nil || (p.Method.Get == nil && p.Method.Post == nil):
+		v.add(prefix+".method", "one of get or post is required")
+	case p.Method.Get != nil && p.Method.Post != nil:
+		v.add(prefix+".method", "only one of get or post is allowed")
+	case p.Method.Get != nil:
+		v.httpExpectation(prefix+".method.get", p.Method.Get.Criteria, p.Method.Get.ResponseCode)
+	default:
+		v.httpExpectation(prefix+".method.post", p.Method.Post.Criteria, p.Method.Post.ResponseCode)
+	}
+}
+
+func (v *validator) httpExpectation(prefix, criteria, responseCode string) {
+	if !contains(httpCriteria, criteria) {
+		v.add(prefix+".criteria", "invalid criteria %q, expected one of %s", criteria, strings.Join(httpCriteria, ", "))
+		return
+	}
+
+	codes := []string{responseCode}
+	if criteria == "oneOf" {
+		var err error
+		if codes, err = parseList(responseCode); err != nil {
+			v.add(prefix+".responseCode", "%v", err)
+			return
+		}
+	}
+	for _, code := range codes {
+		if n, err := strconv.Atoi(code); err != nil || n < 100 || n > 599 {
+			v.add(prefix+".responseCode", "invalid HTTP status code %q", code)
+			return
+		}
+	}
+}
+
+func (v *validator) cmd(prefix string, p *KubernetesCMDProbeRequest) {
+	v.run(prefix, p.ProbeTimeout, p.Interval, p.Attempt)
+	if strings.TrimSpace(p.Command) == "" {
+		v.add(prefix+".command", "is required")
+	}
+	if p.Comparator == nil {
+		v.add(prefix+".comparator", "is required")
+		return
+	}
+	v.comparator(prefix+".comparator", p.Comparator.Type, p.Comparator.Criteria, p.Comparator.Value)
+}
+
+func (v *validator) k8s(prefix string, p *K8SProbeRequest) {
+	v.run(prefix, p.ProbeTimeout, p.Interval, p.Attempt)
+	if p.Version == "" {
+		v.add(prefix+".version", "is required")
+	}
+	if p.Resource == "" {
+		v.add(prefix+".resource", "is required")
+	}
+	if !contains(k8sOperations, p.Operation) {
+		v.add(prefix+".operation", "invalid operation %q, expected one of %s", p.Operation, strings.Join(k8sOperations, ", "))
+	}
+}
+
+func (v *validator) prom(prefix string, p *PROMProbeRequest) {
+	v.run(prefix, p.ProbeTimeout, p.Interval, p.Attempt)
+	v.endpoint(prefix+".endpoint", p.Endpoint)
+	switch {
+	case p.Query != "":
+		// A query in queryPath lives in the probe pod and cannot be checked here
+		if err := ValidatePromQL(p.Query); err != nil {
+			v.add(prefix+".query", "%v", err)
+		}
+	case p.QueryPath == nil || *p.QueryPath == "":
+		v.add(prefix+".query", "one of query or queryPath is required")
+	}
+
+	if p.Comparator == nil {
+		v.add(prefix+".comparator", "is required")
+		return
+	}
+	// Prometheus results are always compared as floats
+	if p.Comparator.Type != ComparatorTypeFloat {
+		v.add(prefix+".comparator.type", "must be %s, got %q", ComparatorTypeFloat, p.Comparator.Type)
+		return
+	}
+	v.comparator(prefix+".comparator", p.Comparator.Type, p.Comparator.Criteria, p.Comparator.Value)
+}
+
+// endpoint checks that a Prometheus endpoint is an absolute HTTP URL
+func (v *validator) endpoint(field, endpoint string) {
+	if endpoint == "" {
+		v.add(field, "is required")
+		return
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		v.add(field, "invalid URL: %v", err)
+		return
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		v.add(field, "invalid URL %q, expected an http or https URL such as http://prometheus:9090", endpoint)
+		return
+	}
+	if u.Host == "" {
+		v.add(field, "invalid URL %q, the host is missing", endpoint)
+	}
+}
+
+func (v *validator) comparator(prefix, comparatorType, criteria, value string) {
+	allowed, ok := comparatorCriteria[comparatorType]
+	if !ok {
+		v.add(prefix+".type", "invalid type %q, expected int, float or string", comparatorType)
+		return
+	}
+	if !contains(allowed, criteria) {
+		v.add(prefix+".criteria", "invalid criteria %q for type %s, expected one of %s", criteria, comparatorType, strings.Join(allowed, ", "))
+		return
+	}
+	v.comparatorValue(prefix+".value", comparatorType, criteria, value)
+}
+
+// comparatorValue checks that the value parses as the comparator type. oneOf
+// and between take a list such as [1,2], between exactly two bounds.
+func (v *validator) comparatorValue(field, comparatorType, criteria, value string) {
+	values := []string{value}
+	if criteria == "oneOf" || criteria == "between" {
+		var err error
+		if values, err = parseList(value); err != nil {
+			v.add(field, "%v", err)
+			return
+		}
+		if criteria == "between" && len(values) != 2 {
+			v.add(field, "between takes exactly two bounds, got %d", len(values))
+			return
+		}
+	}
+
+	for _, value := range values {
+		switch comparatorType {
+		case ComparatorTypeInt:
+			if _, err := strconv.Atoi(value); err != nil {
+				v.add(field, "%q is not an integer", value)
+				return
+			}
+		case ComparatorTypeFloat:
+			if _, err := strconv.ParseFloat(value, 64); err != nil {
+				v.add(field, "%q is not a number", value)
+				return
+			}
+		case ComparatorTypeString:
+			if criteria == "matches" || criteria == "notMatches" {
+				if _, err := regexp.Compile(value); err != nil {
+					v.add(field, "invalid regular expression: %v", err)
+					return
+				}
+			}
+		}
+	}
+}
+
+// parseList splits a bracketed, comma separated list such as [200,201]
+func parseList(value string) ([]string, error) {
+	trimmed := strings.TrimSpace(value)
+	if !strings.HasPrefix(trimmed, "[") || !strings.HasSuffix(trimmed, "]") {
+		return nil, fmt.Errorf("%q is not a list, expected a value such as [a,b]", value)
+	}
+
+	var items []string
+	for _, item := range strings.Split(trimmed[1:len(trimmed)-1], ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("list %q is empty", value)
+	}
+	return items, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
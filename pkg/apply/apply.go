@@ -0,0 +1,443 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package apply
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/probe"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/manifest"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/sdk"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+// Options configures Apply
+type Options struct {
+	// Prune deletes the resources tagged with ManagedTag which are missing from the spec
+	Prune bool
+
+	// DryRun computes and prints the plan without executing it
+	DryRun bool
+
+	// Out receives the plan before it is executed, nothing is printed when nil
+	Out io.Writer
+}
+
+// NewPlan compares a spec with the project of the client and returns the
+// changes which reconcile them
+func NewPlan(client sdk.Client, spec Spec, options Options) (Plan, error) {
+	if err := spec.Validate(); err != nil {
+		return Plan{}, err
+	}
+
+	p := planner{
+		client:    client,
+		projectID: client.Auth().GetCredentials().ProjectID,
+		prune:     options.Prune,
+	}
+	if p.projectID == "" {
+		return Plan{}, fmt.Errorf("project ID not set in credentials")
+	}
+
+	environments, environmentDeletes, err := p.environments(spec.Environments)
+	if err != nil {
+		return Plan{}, err
+	}
+	probes, probeDeletes, err := p.probes(spec.Probes)
+	if err != nil {
+		return Plan{}, err
+	}
+	experiments, experimentDeletes, err := p.experiments(spec.Experiments)
+	if err != nil {
+		return Plan{}, err
+	}
+
+	var plan Plan
+	for _, changes := range [][]Change{environments, probes, experiments, experimentDeletes, probeDeletes, environmentDeletes} {
+		plan.Changes = append(plan.Changes, changes...)
+	}
+	return plan, nil
+}
+
+// Apply reconciles the project of the client with a spec. The plan is printed
+// to Out and executed in order, stopping at the first failure. Applying the
+// same spec again plans no changes.
+func Apply(client sdk.Client, spec Spec, options Options) (Plan, error) {
+	plan, err := NewPlan(client, spec, options)
+	if err != nil {
+		return Plan{}, err
+	}
+
+	if options.Out != nil {
+		fmt.Fprint(options.Out, plan.String())
+	}
+	if options.DryRun {
+		return plan, nil
+	}
+
+	for _, change := range plan.Changes {
+		if change.execute == nil {
+			continue
+		}
+		if err := change.execute(); err != nil {
+			return plan, fmt.Errorf("failed to %s %s %s: %w", change.Action, change.Kind, change.Name, err)
+		}
+	}
+	return plan, nil
+}
+
+type planner struct {
+	client    sdk.Client
+	projectID string
+	prune     bool
+}
+
+func (p planner) environments(specs []EnvironmentSpec) ([]Change, []Change, error) {
+	response, err := p.client.Environments().List()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	existing := map[string]*models.Environment{}
+	for _, e := range response.Environments {
+		if e != nil && (e.IsRemoved == nil || !*e.IsRemoved) {
+			existing[e.EnvironmentID] = e
+		}
+	}
+
+	var changes []Change
+	desired := map[string]bool{}
+	for _, want := range specs {
+		desired[want.ID] = true
+		tags := managedTags(want.Tags)
+		change := Change{Change: sdk.Change{Kind: KindEnvironment, Name: want.ID}}
+
+		current, ok := existing[want.ID]
+		if !ok {
+			change.Action = ActionCreate
+			change.execute = func() error {
+				_, err := p.client.Environments().Create(want.Name, models.CreateEnvironmentRequest{
+					EnvironmentID: want.ID,
+					Name:          want.Name,
+					Type:          want.Type,
+					Description:   optional(want.Description),
+					Tags:          tags,
+				})
+				return err
+			}
+			changes = append(changes, change)
+			continue
+		}
+
+		if current.Name != want.Name {
+			change.Fields = append(change.Fields, "name")
+		}
+		if current.Type != want.Type {
+			change.Fields = append(change.Fields, "type")
+		}
+		if deref(current.Description) != want.Description {
+			change.Fields = append(change.Fields, "description")
+		}
+		if !sameTags(current.Tags, tags) {
+			change.Fields = append(change.Fields, "tags")
+		}
+
+		change.Action = ActionNoOp
+		if len(change.Fields) > 0 {
+			change.Action = ActionUpdate
+			change.execute = func() error {
+				request := models.UpdateEnvironmentRequest{
+					EnvironmentID: want.ID,
+					Name:          &want.Name,
+					Description:   &want.Description,
+					Type:          &want.Type,
+				}
+				for i := range tags {
+					request.Tags = append(request.Tags, &tags[i])
+				}
+				return p.client.Environments().Update(request)
+			}
+		}
+		changes = append(changes, change)
+	}
+
+	var deletes []Change
+	if p.prune {
+		for _, e := range response.Environments {
+			if e == nil || existing[e.EnvironmentID] == nil || desired[e.EnvironmentID] || !hasTag(e.Tags, ManagedTag) {
+				continue
+			}
+			id := e.EnvironmentID
+			deletes = append(deletes, Change{Change: sdk.Change{Kind: KindEnvironment, Name: id, Action: ActionDelete}, execute: func() error {
+				return p.client.Environments().Delete(id)
+			}})
+		}
+	}
+
+	return changes, deletes, nil
+}
+
+func (p planner) probes(specs []probe.ProbeRequest) ([]Change, []Change, error) {
+	list, err := p.client.Probes().List(p.projectID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	existing := map[string]bool{}
+	for _, current := range list {
+		existing[current.Name] = true
+	}
+
+	var changes []Change
+	desired := map[string]bool{}
+	for _, want := range specs {
+		desired[want.Name] = true
+		want.Tags = managedTags(want.Tags)
+		change := Change{Change: sdk.Change{Kind: KindProbe, Name: want.Name}}
+
+		if !existing[want.Name] {
+			change.Action = ActionCreate
+			change.execute = func() error {
+				_, err := p.client.Probes().Create(want, p.projectID)
+				return err
+			}
+			changes = append(changes, change)
+			continue
+		}
+
+		current, err := p.client.Probes().Get(p.projectID, want.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+		change.Fields, err = probe.Diff(want, probe.RequestFromModel(current))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to compare probe %s: %w", want.Name, err)
+		}
+
+		change.Action = ActionNoOp
+		if len(change.Fields) > 0 {
+			change.Action = ActionUpdate
+			change.execute = func() error {
+				return p.client.Probes().Update(p.projectID, want)
+			}
+		}
+		changes = append(changes, change)
+	}
+
+	var deletes []Change
+	if p.prune {
+		for _, current := range list {
+			if desired[current.Name] || !hasTag(current.Tags, ManagedTag) {
+				continue
+			}
+			name := current.Name
+			deletes = append(deletes, Change{Change: sdk.Change{Kind: KindProbe, Name: name, Action: ActionDelete}, execute: func() error {
+				return p.client.Probes().Delete(p.projectID, name)
+			}})
+		}
+	}
+
+	return changes, deletes, nil
+}
+
+func (p planner) experiments(specs []ExperimentSpec) ([]Change, []Change, error) {
+	infras, err := p.client.Infrastructure().List()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	list, err := p.client.Experiments().Select(sdk.ExperimentSelector{})
+	if err != nil {
+		return nil, nil, err
+	}
+	existing := map[string]*models.Experiment{}
+	for _, e := range list {
+		existing[e.Name] = e
+	}
+
+	var changes []Change
+	desired := map[string]bool{}
+	for _, want := range specs {
+		desired[want.Name] = true
+		change := Change{Change: sdk.Change{Kind: KindExperiment, Name: want.Name}}
+
+		infraID, err := resolveInfra(infras.Infras, want.Infra)
+		if err != nil {
+			return nil, nil, fmt.Errorf("experiment %s: %w", want.Name, err)
+		}
+
+		current, ok := existing[want.Name]
+		request := models.SaveChaosExperimentRequest{
+			ID:          uuid.New().String(),
+			Name:        want.Name,
+			Description: want.Description,
+			Tags:        managedTags(want.Tags),
+			InfraID:     infraID,
+		}
+		if ok {
+			request.ID = current.ExperimentID
+		}
+		if kind, _ := manifest.Kind(string(want.Manifest)); kind == manifest.KindCronWorkflow {
+			experimentType := models.ExperimentTypeCronExperiment
+			request.Type = &experimentType
+		}
+
+		// Both manifests get the labels ChaosCenter manages, so that only user changes are compared
+		target := manifest.RetargetOptions{Name: request.Name, ExperimentID: request.ID, InfraID: infraID}
+		request.Manifest, err = manifest.Retarget(string(want.Manifest), target)
+		if err != nil {
+			return nil, nil, fmt.Errorf("experiment %s: %w", want.Name, err)
+		}
+
+		save := func() error {
+			_, err := p.client.Experiments().Update(request.ID, request)
+			return err
+		}
+
+		if !ok {
+			change.Action = ActionCreate
+			change.execute = save
+			changes = append(changes, change)
+			continue
+		}
+
+		if current.Description != want.Description {
+			change.Fields = append(change.Fields, "description")
+		}
+		if !sameTags(current.Tags, request.Tags) {
+			change.Fields = append(change.Fields, "tags")
+		}
+		if current.Infra == nil || current.Infra.InfraID != infraID {
+			change.Fields = append(change.Fields, "infra")
+		}
+		if changed, err := manifestChanged(current.ExperimentManifest, request.Manifest, target); err != nil || changed {
+			change.Fields = append(change.Fields, "manifest")
+		}
+
+		change.Action = ActionNoOp
+		if len(change.Fields) > 0 {
+			change.Action = ActionUpdate
+			change.execute = save
+		}
+		changes = append(changes, change)
+	}
+
+	var deletes []Change
+	if p.prune {
+		for _, e := range list {
+			if desired[e.Name] || !hasTag(e.Tags, ManagedTag) {
+				continue
+			}
+			id := e.ExperimentID
+			deletes = append(deletes, Change{Change: sdk.Change{Kind: KindExperiment, Name: e.Name, Action: ActionDelete}, execute: func() error {
+				return p.client.Experiments().Delete(id)
+			}})
+		}
+	}
+
+	return changes, deletes, nil
+}
+
+// resolveInfra finds a chaos infrastructure by ID, then by name
+func resolveInfra(infras []*models.Infra, ref string) (string, error) {
+	var byName []string
+	for _, i := range infras {
+		if i == nil || i.IsRemoved {
+			continue
+		}
+		if i.InfraID == ref {
+			return i.InfraID, nil
+		}
+		if i.Name == ref {
+			byName = append(byName, i.InfraID)
+		}
+	}
+	switch len(byName) {
+	case 0:
+		return "", fmt.Errorf("infra %q not found", ref)
+	case 1:
+		return byName[0], nil
+	default:
+		return "", fmt.Errorf("infra name %q is ambiguous, use its ID", ref)
+	}
+}
+
+// manifestChanged compares the server manifest, retargeted like the desired one, with the desired manifest
+func manifestChanged(current string, desired string, target manifest.RetargetOptions) (bool, error) {
+	if current == "" {
+		return true, nil
+	}
+	normalized, err := manifest.Retarget(current, target)
+	if err != nil {
+		return true, err
+	}
+	diff, err := manifest.Compare(normalized, desired)
+	if err != nil {
+		return true, err
+	}
+	return !diff.Empty(), nil
+}
+
+// managedTags returns the tags with ManagedTag added
+func managedTags(tags []string) []string {
+	if hasTag(tags, ManagedTag) {
+		return tags
+	}
+	return append(append([]string{}, tags...), ManagedTag)
+}
+
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// sameTags compares tags regardless of their order
+func sameTags(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, t := range a {
+		if !hasTag(b, t) {
+			return false
+		}
+	}
+	for _, t := range b {
+		if !hasTag(a, t) {
+			return false
+		}
+	}
+	return true
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,369 @@
+package apply
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/probe"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/sdk"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/types"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeProject is an in-memory project served through the sdk client interfaces
+type fakeProject struct {
+	sdk.Client
+	environments []*models.Environment
+	infras       []*models.Infra
+	experiments  []*models.Experiment
+	probes       []models.Probe
+	calls        []string
+}
+
+func (p *fakeProject) Auth() sdk.AuthClient                     { return fakeAuth{} }
+func (p *fakeProject) Environments() sdk.EnvironmentClient      { return fakeEnvironments{p: p} }
+func (p *fakeProject) Infrastructure() sdk.InfrastructureClient { return fakeInfras{p: p} }
+func (p *fakeProject) Experiments() sdk.ExperimentClient        { return fakeExperiments{p: p} }
+func (p *fakeProject) Probes() sdk.ProbeClient                  { return fakeProbes{p: p} }
+
+type fakeAuth struct{}
+
+func (fakeAuth) GetToken() string                  { return "" }
+func (fakeAuth) GetCredentials() types.Credentials { return types.Credentials{ProjectID: "project"} }
+
+type fakeEnvironments struct {
+	sdk.EnvironmentClient
+	p *fakeProject
+}
+
+func (f fakeEnvironments) List() (models.ListEnvironmentResponse, error) {
+	return models.ListEnvironmentResponse{Environments: f.p.environments}, nil
+}
+
+func (f fakeEnvironments) Create(name string, request models.CreateEnvironmentRequest) (models.Environment, error) {
+	f.p.calls = append(f.p.calls, "create environment "+request.EnvironmentID)
+	env := models.Environment{EnvironmentID: request.EnvironmentID, Name: name, Type: request.Type, Description: request.Description, Tags: request.Tags}
+	f.p.environments = append(f.p.environments, &env)
+	return env, nil
+}
+
+func (f fakeEnvironments) Update(request models.UpdateEnvironmentRequest) error {
+	f.p.calls = append(f.p.calls, "update environment "+request.EnvironmentID)
+	for _, e := range f.p.environments {
+		if e.EnvironmentID == request.EnvironmentID {
+			e.Name = *request.Name
+			e.Type = *request.Type
+			e.Description = request.Description
+			e.Tags = nil
+			for _, t := range request.Tags {
+				e.Tags = append(e.Tags, *t)
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("environment %s not found", request.EnvironmentID)
+}
+
+func (f fakeEnvironments) Delete(id string) error {
+	f.p.calls = append(f.p.calls, "delete environment "+id)
+	for i, e := range f.p.environments {
+		if e.EnvironmentID == id {
+			f.p.environments = append(f.p.environments[:i], f.p.environments[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("environment %s not found", id)
+}
+
+type fakeInfras struct {
+	sdk.InfrastructureClient
+	p *fakeProject
+}
+
+func (f fakeInfras) List() (models.ListInfraResponse, error) {
+	return models.ListInfraResponse{Infras: f.p.infras}, nil
+}
+
+type fakeExperiments struct {
+	sdk.ExperimentClient
+	p *fakeProject
+}
+
+func (f fakeExperiments) Select(sdk.ExperimentSelector) ([]*models.Experiment, error) {
+	return f.p.experiments, nil
+}
+
+func (f fakeExperiments) Update(id string, request models.SaveChaosExperimentRequest) (string, error) {
+	experiment := &models.Experiment{
+		ExperimentID:       id,
+		Name:               request.Name,
+		Description:        request.Description,
+		Tags:               request.Tags,
+		Infra:              &models.Infra{InfraID: request.InfraID},
+		ExperimentManifest: request.Manifest,
+	}
+	for i, e := range f.p.experiments {
+		if e.ExperimentID == id {
+			f.p.calls = append(f.p.calls, "update experiment "+request.Name)
+			f.p.experiments[i] = experiment
+			return "updated", nil
+		}
+	}
+	f.p.calls = append(f.p.calls, "create experiment "+request.Name)
+	f.p.experiments = append(f.p.experiments, experiment)
+	return "created", nil
+}
+
+func (f fakeExperiments) Delete(id string) error {
+	for i, e := range f.p.experiments {
+		if e.ExperimentID == id {
+			f.p.calls = append(f.p.calls, "delete experiment "+e.Name)
+			f.p.experiments = append(f.p.experiments[:i], f.p.experiments[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("experiment %s not found", id)
+}
+
+type fakeProbes struct {
+	sdk.ProbeClient
+	p *fakeProject
+}
+
+func (f fakeProbes) List(string) ([]models.Probe, error) { return f.p.probes, nil }
+
+func (f fakeProbes) Get(_ string, name string) (models.Probe, error) {
+	for _, p := range f.p.probes {
+		if p.Name == name {
+			return p, nil
+		}
+	}
+	return models.Probe{}, fmt.Errorf("probe %s not found", name)
+}
+
+func (f fakeProbes) Create(request probe.ProbeRequest, _ string) (models.Probe, error) {
+	f.p.calls = append(f.p.calls, "create probe "+request.Name)
+	created := probe.ModelFromRequest(request)
+	f.p.probes = append(f.p.probes, created)
+	return created, nil
+}
+
+func (f fakeProbes) Update(_ string, request probe.ProbeRequest) error {
+	f.p.calls = append(f.p.calls, "update probe "+request.Name)
+	for i, p := range f.p.probes {
+		if p.Name == request.Name {
+			f.p.probes = append(f.p.probes[:i], f.p.probes[i+1:]...)
+			_, err := f.Create(request, "")
+			f.p.calls = f.p.calls[:len(f.p.calls)-1]
+			return err
+		}
+	}
+	return fmt.Errorf("probe %s not found", request.Name)
+}
+
+func (f fakeProbes) Delete(_ string, name string) error {
+	f.p.calls = append(f.p.calls, "delete probe "+name)
+	for i, p := range f.p.probes {
+		if p.Name == name {
+			f.p.probes = append(f.p.probes[:i], f.p.probes[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("probe %s not found", name)
+}
+
+const workflow = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: placeholder
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+`
+
+const specYAML = `environments:
+  - id: staging
+    name: Staging
+    type: NON_PROD
+probes:
+  - name: always-true
+    type: cmdProbe
+    infrastructureType: Kubernetes
+    kubernetesCMDProperties:
+      command: echo ok
+      probeTimeout: 5s
+      interval: 1s
+      comparator:
+        type: string
+        criteria: equal
+        value: ok
+experiments:
+  - name: pod-delete
+    description: deletes a pod
+    infra: staging-cluster
+    manifestFile: pod-delete.yaml
+`
+
+func writeSpec(t *testing.T) string {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "spec.yaml"), []byte(specYAML), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pod-delete.yaml"), []byte(workflow), 0o600))
+	return filepath.Join(dir, "spec.yaml")
+}
+
+func emptyProject() *fakeProject {
+	return &fakeProject{
+		infras: []*models.Infra{{InfraID: "infra-1", Name: "staging-cluster"}},
+	}
+}
+
+func TestLoad(t *testing.T) {
+	spec, err := Load(writeSpec(t))
+	require.NoError(t, err)
+
+	require.Len(t, spec.Environments, 1)
+	assert.Equal(t, models.EnvironmentTypeNonProd, spec.Environments[0].Type)
+	require.Len(t, spec.Probes, 1)
+	require.NotNil(t, spec.Probes[0].KubernetesCMDProperties)
+	assert.Equal(t, "echo ok", spec.Probes[0].KubernetesCMDProperties.Command)
+	require.Len(t, spec.Experiments, 1)
+	assert.Equal(t, Manifest(workflow), spec.Experiments[0].Manifest)
+}
+
+func TestParseInlineManifest(t *testing.T) {
+	spec, err := Parse([]byte(`experiments:
+  - name: inline
+    infra: infra-1
+    manifest:
+      kind: Workflow
+      metadata:
+        name: inline
+`))
+	require.NoError(t, err)
+	require.Len(t, spec.Experiments, 1)
+	assert.JSONEq(t, `{"kind":"Workflow","metadata":{"name":"inline"}}`, string(spec.Experiments[0].Manifest))
+	assert.NoError(t, spec.Validate())
+}
+
+func TestParseRejectsUnknownFields(t *testing.T) {
+	_, err := Parse([]byte("environments:\n  - id: a\n    nmae: A\n"))
+	assert.Error(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	tests := map[string]Spec{
+		"environment without type": {Environments: []EnvironmentSpec{{ID: "a", Name: "A"}}},
+		"duplicate probe":          {Probes: []probe.ProbeRequest{{Name: "p"}, {Name: "p"}}},
+		"invalid probe interval": {Probes: []probe.ProbeRequest{{
+			Name: "p", Type: probe.ProbeTypeCMDProbe,
+			KubernetesCMDProperties: &probe.KubernetesCMDProbeRequest{Command: "true", ProbeTimeout: "5s", Interval: "soon"},
+		}}},
+		"experiment without infra":  {Experiments: []ExperimentSpec{{Name: "e", Manifest: Manifest(workflow)}}},
+		"experiment not a workflow": {Experiments: []ExperimentSpec{{Name: "e", Infra: "i", Manifest: `{"kind":"Pod"}`}}},
+	}
+	for name, spec := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Error(t, spec.Validate())
+		})
+	}
+}
+
+func TestApplyIsIdempotent(t *testing.T) {
+	spec, err := Load(writeSpec(t))
+	require.NoError(t, err)
+	project := emptyProject()
+
+	var out bytes.Buffer
+	plan, err := Apply(project, spec, Options{Out: &out})
+	require.NoError(t, err)
+	assert.Equal(t, 3, plan.Count(ActionCreate))
+	assert.Contains(t, out.String(), "+ experiment pod-delete")
+	assert.Contains(t, out.String(), "Plan: 3 to create, 0 to update, 0 to delete, 0 unchanged")
+	assert.Equal(t, []string{"create environment staging", "create probe always-true", "create experiment pod-delete"}, project.calls)
+
+	require.Len(t, project.experiments, 1)
+	assert.Equal(t, "infra-1", project.experiments[0].Infra.InfraID)
+	assert.Contains(t, project.experiments[0].Tags, ManagedTag)
+	assert.Contains(t, project.experiments[0].ExperimentManifest, "pod-delete")
+
+	plan, err = NewPlan(project, spec, Options{})
+	require.NoError(t, err)
+	assert.False(t, plan.HasChanges(), plan.String())
+	assert.Equal(t, 3, plan.Count(ActionNoOp))
+}
+
+func TestApplyUpdates(t *testing.T) {
+	spec, err := Load(writeSpec(t))
+	require.NoError(t, err)
+	project := emptyProject()
+	_, err = Apply(project, spec, Options{})
+	require.NoError(t, err)
+	project.calls = nil
+
+	spec.Environments[0].Name = "Staging EU"
+	spec.Probes[0].KubernetesCMDProperties.Interval = "2s"
+	spec.Experiments[0].Description = "deletes two pods"
+
+	plan, err := Apply(project, spec, Options{})
+	require.NoError(t, err)
+	assert.Equal(t, 3, plan.Count(ActionUpdate))
+	assert.Equal(t, []string{"name"}, plan.Changes[0].Fields)
+	assert.Equal(t, []string{"kubernetesCMDProperties.interval"}, plan.Changes[1].Fields)
+	assert.Equal(t, []string{"description"}, plan.Changes[2].Fields)
+	assert.Equal(t, []string{
+		"update environment staging",
+		"update probe always-true",
+		"update experiment pod-delete",
+	}, project.calls)
+
+	plan, err = NewPlan(project, spec, Options{})
+	require.NoError(t, err)
+	assert.False(t, plan.HasChanges(), plan.String())
+}
+
+func TestApplyPrunesOnlyManagedResources(t *testing.T) {
+	spec, err := Load(writeSpec(t))
+	require.NoError(t, err)
+	project := emptyProject()
+	_, err = Apply(project, spec, Options{})
+	require.NoError(t, err)
+
+	project.environments = append(project.environments, &models.Environment{EnvironmentID: "manual", Name: "Manual"})
+	project.probes = append(project.probes, models.Probe{Name: "manual-probe"})
+	project.calls = nil
+
+	plan, err := Apply(project, Spec{}, Options{Prune: true})
+	require.NoError(t, err)
+	assert.Equal(t, 3, plan.Count(ActionDelete))
+	assert.Equal(t, []string{"delete experiment pod-delete", "delete probe always-true", "delete environment staging"}, project.calls)
+	require.Len(t, project.environments, 1)
+	assert.Equal(t, "manual", project.environments[0].EnvironmentID)
+	require.Len(t, project.probes, 1)
+	assert.Equal(t, "manual-probe", project.probes[0].Name)
+}
+
+func TestApplyDryRun(t *testing.T) {
+	spec, err := Load(writeSpec(t))
+	require.NoError(t, err)
+	project := emptyProject()
+
+	plan, err := Apply(project, spec, Options{DryRun: true})
+	require.NoError(t, err)
+	assert.True(t, plan.HasChanges())
+	assert.Empty(t, project.calls)
+}
+
+func TestApplyUnknownInfra(t *testing.T) {
+	spec, err := Load(writeSpec(t))
+	require.NoError(t, err)
+	spec.Experiments[0].Infra = "missing"
+
+	_, err = Apply(emptyProject(), spec, Options{})
+	assert.ErrorContains(t, err, `infra "missing" not found`)
+}
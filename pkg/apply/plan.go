@@ -0,0 +1,91 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package apply
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/sdk"
+)
+
+// Kind is the kind of a reconciled resource
+type Kind = sdk.ResourceKind
+
+const (
+	KindEnvironment = sdk.KindEnvironment
+	KindProbe       = sdk.KindProbe
+	KindExperiment  = sdk.KindExperiment
+)
+
+// Action is what Apply does with a resource
+type Action = sdk.ChangeAction
+
+const (
+	ActionCreate = sdk.ActionCreate
+	ActionUpdate = sdk.ActionUpdate
+	ActionDelete = sdk.ActionDelete
+	ActionNoOp   = sdk.ActionNoOp
+)
+
+// Change is a planned change to a single resource
+type Change struct {
+	sdk.Change
+
+	execute func() error
+}
+
+// Plan is the ordered list of changes which reconcile a project with a spec.
+// Creates and updates come first, environments before probes before
+// experiments, then deletes in the reverse order.
+type Plan struct {
+	Changes []Change
+}
+
+// HasChanges reports whether applying the plan changes anything
+func (p Plan) HasChanges() bool {
+	for _, c := range p.Changes {
+		if c.Action != ActionNoOp {
+			return true
+		}
+	}
+	return false
+}
+
+// Count returns the number of changes with the given action
+func (p Plan) Count(action Action) int {
+	n := 0
+	for _, c := range p.Changes {
+		if c.Action == action {
+			n++
+		}
+	}
+	return n
+}
+
+// String renders one line per change, unchanged resources omitted, followed by a summary
+func (p Plan) String() string {
+	var b strings.Builder
+	for _, c := range p.Changes {
+		if c.Action != ActionNoOp {
+			b.WriteString(c.String())
+			b.WriteByte('\n')
+		}
+	}
+	fmt.Fprintf(&b, "Plan: %d to create, %d to update, %d to delete, %d unchanged\n",
+		p.Count(ActionCreate), p.Count(ActionUpdate), p.Count(ActionDelete), p.Count(ActionNoOp))
+	return b.String()
+}
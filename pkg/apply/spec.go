@@ -0,0 +1,216 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package apply reconciles a declarative description of the environments,
+// probes and experiments of a project against ChaosCenter.
+//
+// A spec is written in YAML or JSON:
+//
+//	environments:
+//	  - id: staging
+//	    name: Staging
+//	    type: NON_PROD
+//	probes:
+//	  - name: checkout-up
+//	    type: httpProbe
+//	    infrastructureType: Kubernetes
+//	    kubernetesHTTPProperties:
+//	      probeTimeout: 5s
+//	      interval: 2s
+//	      url: http://checkout.shop.svc
+//	      method:
+//	        get:
+//	          criteria: "=="
+//	          responseCode: "200"
+//	experiments:
+//	  - name: checkout-pod-delete
+//	    infra: staging-cluster
+//	    manifestFile: experiments/checkout-pod-delete.yaml
+//
+// Probes use the fields of probe.ProbeRequest. Experiments reference their
+// chaos infrastructure by ID or name and carry their manifest inline, either as
+// a string or as an object, or in a file relative to the spec.
+//
+// Every resource created by Apply is tagged with ManagedTag. Pruning only
+// deletes tagged resources, so resources created by other means are never removed.
+package apply
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/probe"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/manifest"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+// ManagedTag marks the resources owned by Apply
+const ManagedTag = "managed-by:litmus-apply"
+
+// Spec is the desired state of a project
+type Spec struct {
+	Environments []EnvironmentSpec    `json:"environments,omitempty"`
+	Probes       []probe.ProbeRequest `json:"probes,omitempty"`
+	Experiments  []ExperimentSpec     `json:"experiments,omitempty"`
+}
+
+// EnvironmentSpec is the desired state of an environment, identified by its ID
+type EnvironmentSpec struct {
+	ID          string                 `json:"id"`
+	Name        string                 `json:"name"`
+	Type        models.EnvironmentType `json:"type"`
+	Description string                 `json:"description,omitempty"`
+	Tags        []string               `json:"tags,omitempty"`
+}
+
+// ExperimentSpec is the desired state of an experiment, identified by its name
+type ExperimentSpec struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+
+	// Infra is the ID or the name of the chaos infrastructure running the experiment
+	Infra string `json:"infra"`
+
+	// Manifest is the Workflow or CronWorkflow of the experiment
+	Manifest Manifest `json:"manifest,omitempty"`
+
+	// ManifestFile is read into Manifest by Load, relative to the spec file
+	ManifestFile string `json:"manifestFile,omitempty"`
+}
+
+// Manifest is an experiment manifest which may be written in a spec either as
+// a string or as an object
+type Manifest string
+
+// UnmarshalJSON accepts a string or any JSON object
+func (m *Manifest) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte(`"`)) {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*m = Manifest(s)
+		return nil
+	}
+	*m = Manifest(data)
+	return nil
+}
+
+// Parse decodes a YAML or JSON spec. Manifest files are not read.
+func Parse(data []byte) (Spec, error) {
+	var spec Spec
+	if err := manifest.UnmarshalStrict(data, &spec); err != nil {
+		return Spec{}, fmt.Errorf("invalid spec: %w", err)
+	}
+	return spec, nil
+}
+
+// Load reads a spec file along with the manifest files it references, and validates it
+func Load(path string) (Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Spec{}, fmt.Errorf("failed to read spec: %w", err)
+	}
+
+	spec, err := Parse(data)
+	if err != nil {
+		return Spec{}, err
+	}
+
+	dir := filepath.Dir(path)
+	for i, e := range spec.Experiments {
+		if e.ManifestFile == "" {
+			continue
+		}
+		if e.Manifest != "" {
+			return Spec{}, fmt.Errorf("experiment %q sets both manifest and manifestFile", e.Name)
+		}
+		file := e.ManifestFile
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(dir, file)
+		}
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return Spec{}, fmt.Errorf("experiment %q: failed to read manifest: %w", e.Name, err)
+		}
+		spec.Experiments[i].Manifest = Manifest(content)
+	}
+
+	if err := spec.Validate(); err != nil {
+		return Spec{}, err
+	}
+	return spec, nil
+}
+
+// Validate checks that every resource is named, unique and complete
+func (s Spec) Validate() error {
+	environments := map[string]bool{}
+	for i, e := range s.Environments {
+		if e.ID == "" || e.Name == "" {
+			return fmt.Errorf("environment %d: id and name are required", i)
+		}
+		if environments[e.ID] {
+			return fmt.Errorf("duplicate environment %q", e.ID)
+		}
+		environments[e.ID] = true
+		if !e.Type.IsValid() {
+			return fmt.Errorf("environment %q: invalid type %q", e.ID, e.Type)
+		}
+	}
+
+	probes := map[string]bool{}
+	for i, p := range s.Probes {
+		if p.Name == "" {
+			return fmt.Errorf("probe %d: name is required", i)
+		}
+		if probes[p.Name] {
+			return fmt.Errorf("duplicate probe %q", p.Name)
+		}
+		probes[p.Name] = true
+		if err := p.Validate(); err != nil {
+			return fmt.Errorf("probe %q: %w", p.Name, err)
+		}
+	}
+
+	experiments := map[string]bool{}
+	for i, e := range s.Experiments {
+		if e.Name == "" {
+			return fmt.Errorf("experiment %d: name is required", i)
+		}
+		if experiments[e.Name] {
+			return fmt.Errorf("duplicate experiment %q", e.Name)
+		}
+		experiments[e.Name] = true
+		if e.Infra == "" {
+			return fmt.Errorf("experiment %q: infra is required", e.Name)
+		}
+		if e.Manifest == "" {
+			return fmt.Errorf("experiment %q: manifest is required", e.Name)
+		}
+		kind, err := manifest.Kind(string(e.Manifest))
+		if err != nil {
+			return fmt.Errorf("experiment %q: %w", e.Name, err)
+		}
+		if kind != manifest.KindWorkflow && kind != manifest.KindCronWorkflow {
+			return fmt.Errorf("experiment %q: unsupported manifest kind %q", e.Name, kind)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package backup
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/bundle"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	indexFile    = "backup.yaml"
+	bundlePrefix = "bundle"
+)
+
+// Save writes the backup as a gzipped tarball
+func Save(b Backup, file string) error {
+	f, err := os.Create(file)
+	if err != nil {
+		return fmt.Errorf("failed to create backup archive: %w", err)
+	}
+	if err := WriteArchive(b, f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Open reads a backup written with Save
+func Open(file string) (Backup, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return Backup{}, fmt.Errorf("failed to open backup archive: %w", err)
+	}
+	defer f.Close()
+	return ReadArchive(f)
+}
+
+// WriteArchive writes the backup as a gzipped tarball. The archive holds a
+// backup.yaml index and the experiment bundle below bundle/.
+func WriteArchive(b Backup, w io.Writer) error {
+	if b.Version != Version {
+		return fmt.Errorf("unsupported backup version %q, expected %q", b.Version, Version)
+	}
+
+	index, err := yaml.Marshal(b)
+	if err != nil {
+		return fmt.Errorf("failed to marshal backup index: %v", err)
+	}
+
+	bundleFiles, bundleOrder, err := bundle.Encode(b.Experiments)
+	if err != nil {
+		return fmt.Errorf("invalid experiment bundle: %w", err)
+	}
+
+	files := map[string][]byte{indexFile: index}
+	order := []string{indexFile}
+	for _, name := range bundleOrder {
+		prefixed := path.Join(bundlePrefix, name)
+		files[prefixed] = bundleFiles[name]
+		order = append(order, prefixed)
+	}
+
+	return bundle.WriteTarball(w, files, order, b.CreatedAt)
+}
+
+// ReadArchive reads a backup from a gzipped tarball
+func ReadArchive(r io.Reader) (Backup, error) {
+	files, err := bundle.ReadTarball(r)
+	if err != nil {
+		return Backup{}, err
+	}
+
+	index, ok := files[indexFile]
+	if !ok {
+		return Backup{}, fmt.Errorf("%s not found in archive", indexFile)
+	}
+
+	var b Backup
+	if err := yaml.UnmarshalStrict(index, &b); err != nil {
+		return Backup{}, fmt.Errorf("invalid backup index: %v", err)
+	}
+	if b.Version != Version {
+		return Backup{}, fmt.Errorf("unsupported backup version %q, expected %q", b.Version, Version)
+	}
+
+	b.Experiments, err = bundle.Decode(bundle.FileReader(files, bundlePrefix))
+	if err != nil {
+		return Backup{}, fmt.Errorf("invalid experiment bundle: %w", err)
+	}
+	return b, nil
+}
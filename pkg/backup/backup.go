@@ -0,0 +1,276 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package backup captures the configuration of a whole ChaosCenter project
+// into a versioned archive and restores it into another, empty project.
+//
+// A backup holds environments, chaos infrastructure registrations, ChaosHubs,
+// image registry settings, probes and experiments. Cron experiments keep their
+// schedule, including whether it is suspended. Infrastructures are captured as
+// metadata only: restoring registers them again and the new registrations have
+// to be connected by applying their manifest to the cluster.
+package backup
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/probe"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/bundle"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/sdk"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+// Version is the backup format version written by this package
+const Version = "v1"
+
+// Backup is the configuration of a project
+type Backup struct {
+	Version         string          `yaml:"version"`
+	CreatedAt       time.Time       `yaml:"createdAt"`
+	ProjectID       string          `yaml:"projectID"`
+	Environments    []Environment   `yaml:"environments,omitempty"`
+	Infras          []Infra         `yaml:"infras,omitempty"`
+	ChaosHubs       []ChaosHub      `yaml:"chaosHubs,omitempty"`
+	ImageRegistries []ImageRegistry `yaml:"imageRegistries,omitempty"`
+
+	// Experiments holds the experiments and every probe of the project
+	Experiments bundle.Bundle `yaml:"-"`
+}
+
+// Environment is a backed up environment
+type Environment struct {
+	ID          string                 `yaml:"id"`
+	Name        string                 `yaml:"name"`
+	Type        models.EnvironmentType `yaml:"type"`
+	Description string                 `yaml:"description,omitempty"`
+	Tags        []string               `yaml:"tags,omitempty"`
+}
+
+// Infra is the registration of a chaos infrastructure, without its connection state
+type Infra struct {
+	ID                   string   `yaml:"id"`
+	Name                 string   `yaml:"name"`
+	Description          string   `yaml:"description,omitempty"`
+	Tags                 []string `yaml:"tags,omitempty"`
+	EnvironmentID        string   `yaml:"environmentID"`
+	PlatformName         string   `yaml:"platformName,omitempty"`
+	Scope                string   `yaml:"scope,omitempty"`
+	Namespace            string   `yaml:"namespace,omitempty"`
+	ServiceAccount       string   `yaml:"serviceAccount,omitempty"`
+	NamespaceExists      bool     `yaml:"namespaceExists,omitempty"`
+	ServiceAccountExists bool     `yaml:"serviceAccountExists,omitempty"`
+}
+
+// ChaosHub is a backed up ChaosHub. Credentials are only present when the
+// backup was taken with IncludeSecrets.
+type ChaosHub struct {
+	ID            string          `yaml:"id"`
+	Name          string          `yaml:"name"`
+	Description   string          `yaml:"description,omitempty"`
+	Tags          []string        `yaml:"tags,omitempty"`
+	HubType       models.HubType  `yaml:"hubType"`
+	RepoURL       string          `yaml:"repoURL"`
+	RepoBranch    string          `yaml:"repoBranch,omitempty"`
+	RemoteHub     string          `yaml:"remoteHub,omitempty"`
+	IsPrivate     bool            `yaml:"isPrivate,omitempty"`
+	AuthType      models.AuthType `yaml:"authType,omitempty"`
+	Token         string          `yaml:"token,omitempty"`
+	UserName      string          `yaml:"userName,omitempty"`
+	Password      string          `yaml:"password,omitempty"`
+	SSHPrivateKey string          `yaml:"sshPrivateKey,omitempty"`
+	SSHPublicKey  string          `yaml:"sshPublicKey,omitempty"`
+}
+
+// ImageRegistry is a backed up image registry setting
+type ImageRegistry struct {
+	ID              string `yaml:"id"`
+	Name            string `yaml:"name"`
+	RepoName        string `yaml:"repoName"`
+	Type            string `yaml:"type"`
+	SecretName      string `yaml:"secretName,omitempty"`
+	SecretNamespace string `yaml:"secretNamespace,omitempty"`
+	Enabled         bool   `yaml:"enabled,omitempty"`
+	IsDefault       bool   `yaml:"isDefault,omitempty"`
+}
+
+// Options configures Create
+type Options struct {
+	// IncludeSecrets stores the credentials of private ChaosHubs in the backup.
+	// Without them private hubs are restored without authentication.
+	IncludeSecrets bool
+}
+
+// Create takes a backup of the project of the client
+func Create(client sdk.Client, options Options) (Backup, error) {
+	projectID := client.Auth().GetCredentials().ProjectID
+	if projectID == "" {
+		return Backup{}, fmt.Errorf("project ID not set in credentials")
+	}
+
+	b := Backup{
+		Version:   Version,
+		CreatedAt: time.Now().UTC(),
+		ProjectID: projectID,
+	}
+
+	environments, err := client.Environments().List()
+	if err != nil {
+		return Backup{}, err
+	}
+	for _, e := range environments.Environments {
+		if e == nil || (e.IsRemoved != nil && *e.IsRemoved) {
+			continue
+		}
+		b.Environments = append(b.Environments, Environment{
+			ID:          e.EnvironmentID,
+			Name:        e.Name,
+			Type:        e.Type,
+			Description: deref(e.Description),
+			Tags:        e.Tags,
+		})
+	}
+
+	infras, err := client.Infrastructure().List()
+	if err != nil {
+		return Backup{}, err
+	}
+	for _, i := range infras.Infras {
+		if i == nil || i.IsRemoved {
+			continue
+		}
+		b.Infras = append(b.Infras, Infra{
+			ID:                   i.InfraID,
+			Name:                 i.Name,
+			Description:          deref(i.Description),
+			Tags:                 i.Tags,
+			EnvironmentID:        i.EnvironmentID,
+			PlatformName:         i.PlatformName,
+			Scope:                i.InfraScope,
+			Namespace:            deref(i.InfraNamespace),
+			ServiceAccount:       deref(i.ServiceAccount),
+			NamespaceExists:      i.InfraNsExists != nil && *i.InfraNsExists,
+			ServiceAccountExists: i.InfraSaExists != nil && *i.InfraSaExists,
+		})
+	}
+
+	hubs, err := client.ChaosHubs().List()
+	if err != nil {
+		return Backup{}, err
+	}
+	for _, h := range hubs {
+		// the default hub is created with every project
+		if h == nil || h.IsDefault || h.IsRemoved {
+			continue
+		}
+		hub := ChaosHub{
+			ID:          h.ID,
+			Name:        h.Name,
+			Description: deref(h.Description),
+			Tags:        h.Tags,
+			HubType:     h.HubType,
+			RepoURL:     h.RepoURL,
+			RepoBranch:  h.RepoBranch,
+			RemoteHub:   h.RemoteHub,
+			IsPrivate:   h.IsPrivate,
+			AuthType:    h.AuthType,
+		}
+		if options.IncludeSecrets {
+			hub.Token = deref(h.Token)
+			hub.UserName = deref(h.UserName)
+			hub.Password = deref(h.Password)
+			hub.SSHPrivateKey = deref(h.SSHPrivateKey)
+			hub.SSHPublicKey = deref(h.SSHPublicKey)
+		}
+		b.ChaosHubs = append(b.ChaosHubs, hub)
+	}
+
+	registries, err := client.ImageRegistries().List()
+	if err != nil {
+		return Backup{}, err
+	}
+	for _, r := range registries {
+		if r == nil || r.ImageRegistryInfo == nil || (r.IsRemoved != nil && *r.IsRemoved) {
+			continue
+		}
+		info := r.ImageRegistryInfo
+		b.ImageRegistries = append(b.ImageRegistries, ImageRegistry{
+			ID:              r.ImageRegistryID,
+			Name:            info.ImageRegistryName,
+			RepoName:        info.ImageRepoName,
+			Type:            info.ImageRegistryType,
+			SecretName:      deref(info.SecretName),
+			SecretNamespace: deref(info.SecretNamespace),
+			Enabled:         info.EnableRegistry != nil && *info.EnableRegistry,
+			IsDefault:       r.IsDefault,
+		})
+	}
+
+	b.Experiments, err = exportExperiments(client, projectID)
+	if err != nil {
+		return Backup{}, err
+	}
+
+	return b, nil
+}
+
+// exportExperiments bundles every experiment of the project along with every
+// probe, including the ones no experiment references yet
+func exportExperiments(client sdk.Client, projectID string) (bundle.Bundle, error) {
+	experiments, err := client.Experiments().Select(sdk.ExperimentSelector{})
+	if err != nil {
+		return bundle.Bundle{}, err
+	}
+
+	b := bundle.Bundle{
+		Version:         bundle.Version,
+		ExportedAt:      time.Now().UTC(),
+		SourceProjectID: projectID,
+	}
+	if len(experiments) > 0 {
+		ids := make([]string, 0, len(experiments))
+		for _, e := range experiments {
+			ids = append(ids, e.ExperimentID)
+		}
+		if b, err = client.Experiments().Export(ids); err != nil {
+			return bundle.Bundle{}, err
+		}
+	}
+
+	probes, err := client.Probes().List(projectID)
+	if err != nil {
+		return bundle.Bundle{}, err
+	}
+	for _, p := range probes {
+		if _, ok := b.Probe(p.Name); ok {
+			continue
+		}
+		details, err := client.Probes().Get(projectID, p.Name)
+		if err != nil {
+			return bundle.Bundle{}, err
+		}
+		b.Probes = append(b.Probes, probe.RequestFromModel(details))
+	}
+
+	return b, nil
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,301 @@
+package backup
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/probe"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/bundle"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/sdk"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/types"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeProject is an in-memory project served through the sdk client interfaces
+type fakeProject struct {
+	sdk.Client
+	id           string
+	environments []*models.Environment
+	infras       []*models.Infra
+	hubs         []*models.ChaosHubStatus
+	registries   []*models.ImageRegistryResponse
+	experiments  []*models.Experiment
+	probes       []models.Probe
+	imported     []bundle.Bundle
+	importOpts   []sdk.ImportOptions
+}
+
+func (p *fakeProject) Auth() sdk.AuthClient                     { return fakeAuth{p} }
+func (p *fakeProject) Environments() sdk.EnvironmentClient      { return fakeEnvironments{p: p} }
+func (p *fakeProject) Infrastructure() sdk.InfrastructureClient { return fakeInfras{p: p} }
+func (p *fakeProject) ChaosHubs() sdk.ChaosHubClient            { return fakeHubs{p: p} }
+func (p *fakeProject) ImageRegistries() sdk.ImageRegistryClient { return fakeRegistries{p: p} }
+func (p *fakeProject) Experiments() sdk.ExperimentClient        { return fakeExperiments{p: p} }
+func (p *fakeProject) Probes() sdk.ProbeClient                  { return fakeProbes{p: p} }
+
+type fakeAuth struct{ p *fakeProject }
+
+func (fakeAuth) GetToken() string                    { return "" }
+func (a fakeAuth) GetCredentials() types.Credentials { return types.Credentials{ProjectID: a.p.id} }
+
+type fakeEnvironments struct {
+	sdk.EnvironmentClient
+	p *fakeProject
+}
+
+func (f fakeEnvironments) List() (models.ListEnvironmentResponse, error) {
+	return models.ListEnvironmentResponse{Environments: f.p.environments}, nil
+}
+
+func (f fakeEnvironments) Create(name string, request models.CreateEnvironmentRequest) (models.Environment, error) {
+	env := models.Environment{EnvironmentID: request.EnvironmentID, Name: name, Type: request.Type, Description: request.Description, Tags: request.Tags}
+	f.p.environments = append(f.p.environments, &env)
+	return env, nil
+}
+
+type fakeInfras struct {
+	sdk.InfrastructureClient
+	p *fakeProject
+}
+
+func (f fakeInfras) List() (models.ListInfraResponse, error) {
+	return models.ListInfraResponse{Infras: f.p.infras}, nil
+}
+
+func (f fakeInfras) Create(name string, infra types.Infra) (string, error) {
+	id := fmt.Sprintf("new-infra-%d", len(f.p.infras)+1)
+	f.p.infras = append(f.p.infras, &models.Infra{InfraID: id, Name: name, EnvironmentID: infra.EnvironmentID, InfraScope: infra.Mode})
+	return id, nil
+}
+
+type fakeHubs struct {
+	sdk.ChaosHubClient
+	p *fakeProject
+}
+
+func (f fakeHubs) List() ([]*models.ChaosHubStatus, error) { return f.p.hubs, nil }
+
+func (f fakeHubs) Create(request models.CreateChaosHubRequest) (models.ChaosHub, error) {
+	id := fmt.Sprintf("new-hub-%d", len(f.p.hubs)+1)
+	f.p.hubs = append(f.p.hubs, &models.ChaosHubStatus{ID: id, Name: request.Name, HubType: models.HubTypeGit, AuthType: request.AuthType, RepoURL: request.RepoURL})
+	return models.ChaosHub{ID: id, Name: request.Name}, nil
+}
+
+func (f fakeHubs) CreateRemote(request models.CreateRemoteChaosHub) (models.ChaosHub, error) {
+	id := fmt.Sprintf("new-hub-%d", len(f.p.hubs)+1)
+	f.p.hubs = append(f.p.hubs, &models.ChaosHubStatus{ID: id, Name: request.Name, HubType: models.HubTypeRemote, RepoURL: request.RepoURL})
+	return models.ChaosHub{ID: id, Name: request.Name}, nil
+}
+
+type fakeRegistries struct {
+	sdk.ImageRegistryClient
+	p *fakeProject
+}
+
+func (f fakeRegistries) List() ([]*models.ImageRegistryResponse, error) { return f.p.registries, nil }
+
+func (f fakeRegistries) Create(request models.ImageRegistryInput) (models.ImageRegistryResponse, error) {
+	r := models.ImageRegistryResponse{ImageRegistryID: "new-registry", ImageRegistryInfo: &models.ImageRegistry{ImageRegistryName: request.ImageRegistryName}}
+	f.p.registries = append(f.p.registries, &r)
+	return r, nil
+}
+
+type fakeExperiments struct {
+	sdk.ExperimentClient
+	p *fakeProject
+}
+
+func (f fakeExperiments) Select(sdk.ExperimentSelector) ([]*models.Experiment, error) {
+	return f.p.experiments, nil
+}
+
+func (f fakeExperiments) Export(ids []string) (bundle.Bundle, error) {
+	b := bundle.Bundle{Version: bundle.Version, SourceProjectID: f.p.id}
+	for _, id := range ids {
+		for _, e := range f.p.experiments {
+			if e.ExperimentID == id {
+				b.Experiments = append(b.Experiments, bundle.Experiment{ID: id, Name: e.Name, InfraID: e.Infra.InfraID, Manifest: e.ExperimentManifest})
+			}
+		}
+	}
+	b.Probes = append(b.Probes, probe.ProbeRequest{Name: "referenced", Type: probe.ProbeTypeHTTPProbe})
+	return b, nil
+}
+
+func (f fakeExperiments) Import(b bundle.Bundle, options sdk.ImportOptions) (sdk.ImportResult, error) {
+	f.p.imported = append(f.p.imported, b)
+	f.p.importOpts = append(f.p.importOpts, options)
+	var result sdk.ImportResult
+	for i, e := range b.Experiments {
+		result.Experiments = append(result.Experiments, sdk.ImportedExperiment{
+			Name: e.Name, TargetName: e.Name, TargetID: fmt.Sprintf("new-exp-%d", i+1), Action: sdk.ImportCreated,
+		})
+	}
+	return result, nil
+}
+
+type fakeProbes struct {
+	sdk.ProbeClient
+	p *fakeProject
+}
+
+func (f fakeProbes) List(string) ([]models.Probe, error) { return f.p.probes, nil }
+
+func (f fakeProbes) Get(_ string, name string) (models.Probe, error) {
+	for _, p := range f.p.probes {
+		if p.Name == name {
+			return p, nil
+		}
+	}
+	return models.Probe{}, fmt.Errorf("probe %s not found", name)
+}
+
+func strPtr(s string) *string { return &s }
+func boolPtr(b bool) *bool    { return &b }
+
+func sourceProject() *fakeProject {
+	return &fakeProject{
+		id: "source",
+		environments: []*models.Environment{
+			{EnvironmentID: "staging", Name: "Staging", Type: models.EnvironmentTypeNonProd, Description: strPtr("pre-production")},
+			{EnvironmentID: "gone", Name: "Gone", IsRemoved: boolPtr(true)},
+		},
+		infras: []*models.Infra{
+			{InfraID: "infra-1", Name: "staging-cluster", EnvironmentID: "staging", InfraScope: "cluster", InfraNamespace: strPtr("litmus"), InfraNsExists: boolPtr(true)},
+		},
+		hubs: []*models.ChaosHubStatus{
+			{ID: "default", Name: "Litmus ChaosHub", IsDefault: true},
+			{ID: "hub-1", Name: "team-hub", HubType: models.HubTypeGit, RepoURL: "https://github.com/org/hub", RepoBranch: "main", IsPrivate: true, AuthType: models.AuthTypeToken, Token: strPtr("secret")},
+			{ID: "hub-2", Name: "remote-hub", HubType: models.HubTypeRemote, RepoURL: "https://example.com/hub.zip"},
+		},
+		registries: []*models.ImageRegistryResponse{
+			{ImageRegistryID: "registry-1", IsDefault: true, ImageRegistryInfo: &models.ImageRegistry{ImageRegistryName: "docker.io", ImageRepoName: "litmuschaos", ImageRegistryType: "public", EnableRegistry: boolPtr(true)}},
+		},
+		experiments: []*models.Experiment{
+			{ExperimentID: "exp-1", Name: "pod-delete", ExperimentManifest: `{"kind":"Workflow"}`, Infra: &models.Infra{InfraID: "infra-1"}},
+		},
+		probes: []models.Probe{
+			{Name: "referenced", Type: models.ProbeTypeHTTPProbe},
+			{Name: "unused", Type: models.ProbeTypeCmdProbe, KubernetesCMDProperties: &models.KubernetesCMDProbe{Command: "true", ProbeTimeout: "5s", Interval: "1s"}},
+		},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	b, err := Create(sourceProject(), Options{})
+	require.NoError(t, err)
+
+	assert.Equal(t, Version, b.Version)
+	assert.Equal(t, "source", b.ProjectID)
+	assert.Equal(t, []Environment{{ID: "staging", Name: "Staging", Type: models.EnvironmentTypeNonProd, Description: "pre-production"}}, b.Environments)
+	assert.Equal(t, []Infra{{ID: "infra-1", Name: "staging-cluster", EnvironmentID: "staging", Scope: "cluster", Namespace: "litmus", NamespaceExists: true}}, b.Infras)
+
+	require.Len(t, b.ChaosHubs, 2)
+	assert.Equal(t, "team-hub", b.ChaosHubs[0].Name)
+	assert.Empty(t, b.ChaosHubs[0].Token, "secrets are only kept with IncludeSecrets")
+
+	require.Len(t, b.ImageRegistries, 1)
+	assert.Equal(t, ImageRegistry{ID: "registry-1", Name: "docker.io", RepoName: "litmuschaos", Type: "public", Enabled: true, IsDefault: true}, b.ImageRegistries[0])
+
+	require.Len(t, b.Experiments.Experiments, 1)
+	require.Len(t, b.Experiments.Probes, 2)
+	assert.Equal(t, "referenced", b.Experiments.Probes[0].Name)
+	assert.Equal(t, "unused", b.Experiments.Probes[1].Name)
+	assert.Equal(t, "true", b.Experiments.Probes[1].KubernetesCMDProperties.Command)
+}
+
+func TestCreateWithSecrets(t *testing.T) {
+	b, err := Create(sourceProject(), Options{IncludeSecrets: true})
+	require.NoError(t, err)
+	assert.Equal(t, "secret", b.ChaosHubs[0].Token)
+}
+
+func TestArchiveRoundTrip(t *testing.T) {
+	b, err := Create(sourceProject(), Options{})
+	require.NoError(t, err)
+	b.CreatedAt = time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	file := filepath.Join(t.TempDir(), "backup.tar.gz")
+	require.NoError(t, Save(b, file))
+
+	got, err := Open(file)
+	require.NoError(t, err)
+	assert.Equal(t, b.Environments, got.Environments)
+	assert.Equal(t, b.Infras, got.Infras)
+	assert.Equal(t, b.ChaosHubs, got.ChaosHubs)
+	assert.Equal(t, b.ImageRegistries, got.ImageRegistries)
+	assert.True(t, b.CreatedAt.Equal(got.CreatedAt))
+	assert.Equal(t, b.Experiments.Probes, got.Experiments.Probes)
+	require.Len(t, got.Experiments.Experiments, 1)
+	assert.Equal(t, "pod-delete", got.Experiments.Experiments[0].Name)
+}
+
+func TestRestore(t *testing.T) {
+	b, err := Create(sourceProject(), Options{IncludeSecrets: true})
+	require.NoError(t, err)
+
+	target := &fakeProject{id: "target"}
+	result, err := Restore(target, b, RestoreOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"staging": "staging"}, result.Mapping.Environments)
+	assert.Equal(t, map[string]string{"infra-1": "new-infra-1"}, result.Mapping.Infras)
+	assert.Equal(t, []string{"new-infra-1"}, result.RegisteredInfras)
+	assert.Equal(t, map[string]string{"hub-1": "new-hub-1", "hub-2": "new-hub-2"}, result.Mapping.ChaosHubs)
+	assert.Equal(t, map[string]string{"registry-1": "new-registry"}, result.Mapping.Registries)
+	assert.Equal(t, map[string]string{"exp-1": "new-exp-1"}, result.Mapping.Experiments)
+
+	assert.Equal(t, "staging", target.infras[0].EnvironmentID)
+	assert.Equal(t, models.AuthTypeToken, target.hubs[0].AuthType)
+	assert.Equal(t, models.HubTypeRemote, target.hubs[1].HubType)
+	require.Len(t, target.importOpts, 1)
+	assert.Equal(t, map[string]string{"infra-1": "new-infra-1"}, target.importOpts[0].InfraMapping)
+	assert.Len(t, target.imported[0].Probes, 2)
+
+	assert.Contains(t, result.Mapping.String(), "infra infra-1 -> new-infra-1\n")
+}
+
+func TestRestoreWithoutSecretsDropsAuth(t *testing.T) {
+	b, err := Create(sourceProject(), Options{})
+	require.NoError(t, err)
+
+	target := &fakeProject{id: "target"}
+	_, err = Restore(target, b, RestoreOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, models.AuthTypeNone, target.hubs[0].AuthType)
+}
+
+func TestRestoreInfraMapping(t *testing.T) {
+	b, err := Create(sourceProject(), Options{})
+	require.NoError(t, err)
+
+	target := &fakeProject{id: "target"}
+	result, err := Restore(target, b, RestoreOptions{InfraMapping: map[string]string{"infra-1": "existing"}})
+	require.NoError(t, err)
+
+	assert.Empty(t, target.infras)
+	assert.Empty(t, result.RegisteredInfras)
+	assert.Equal(t, "existing", target.importOpts[0].InfraMapping["infra-1"])
+}
+
+func TestRestoreRequiresEmptyProject(t *testing.T) {
+	b, err := Create(sourceProject(), Options{})
+	require.NoError(t, err)
+
+	target := &fakeProject{id: "target", probes: []models.Probe{{Name: "existing"}}}
+	_, err = Restore(target, b, RestoreOptions{})
+	assert.ErrorContains(t, err, "not empty (1 probes)")
+	assert.Empty(t, target.environments)
+
+	_, err = Restore(target, b, RestoreOptions{AllowNonEmpty: true})
+	assert.NoError(t, err)
+}
+
+func TestRestoreRejectsVersion(t *testing.T) {
+	_, err := Restore(&fakeProject{id: "target"}, Backup{Version: "v0"}, RestoreOptions{})
+	assert.ErrorContains(t, err, "unsupported backup version")
+}
@@ -0,0 +1,298 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package backup
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/sdk"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/types"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+// RestoreOptions configures Restore
+type RestoreOptions struct {
+	// AllowNonEmpty restores into a project which already has environments,
+	// infrastructures, experiments or probes. Experiments and probes which
+	// already exist are skipped.
+	AllowNonEmpty bool
+
+	// InfraMapping maps infra IDs of the backup to infrastructures which already
+	// exist in the target project. Mapped infrastructures are not registered again.
+	InfraMapping map[string]string
+}
+
+// Mapping relates the IDs of the backup to the IDs in the restored project
+type Mapping struct {
+	Environments map[string]string
+	Infras       map[string]string
+	ChaosHubs    map[string]string
+	Registries   map[string]string
+	Experiments  map[string]string
+}
+
+// String renders the mapping as one "kind old -> new" line per resource
+func (m Mapping) String() string {
+	var b strings.Builder
+	write := func(kind string, ids map[string]string) {
+		keys := make([]string, 0, len(ids))
+		for k := range ids {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(&b, "%s %s -> %s\n", kind, k, ids[k])
+		}
+	}
+	write("environment", m.Environments)
+	write("infra", m.Infras)
+	write("chaoshub", m.ChaosHubs)
+	write("imageregistry", m.Registries)
+	write("experiment", m.Experiments)
+	return b.String()
+}
+
+// Result is the outcome of a restore
+type Result struct {
+	Mapping Mapping
+
+	// RegisteredInfras lists the new infra IDs which still have to be connected
+	// by applying their manifest to the cluster
+	RegisteredInfras []string
+
+	// Experiments is the outcome of the experiment and probe import
+	Experiments sdk.ImportResult
+}
+
+// Restore recreates a backup in the project of the client, which must be empty
+// unless AllowNonEmpty is set. Resources are restored in dependency order and
+// the restore stops at the first failure, the returned result then holds what
+// was restored so far.
+func Restore(client sdk.Client, b Backup, options RestoreOptions) (Result, error) {
+	result := Result{
+		Mapping: Mapping{
+			Environments: map[string]string{},
+			Infras:       map[string]string{},
+			ChaosHubs:    map[string]string{},
+			Registries:   map[string]string{},
+			Experiments:  map[string]string{},
+		},
+	}
+
+	if b.Version != Version {
+		return result, fmt.Errorf("unsupported backup version %q, expected %q", b.Version, Version)
+	}
+	if err := b.Experiments.Validate(); err != nil {
+		return result, fmt.Errorf("invalid experiment bundle: %w", err)
+	}
+
+	projectID := client.Auth().GetCredentials().ProjectID
+	if projectID == "" {
+		return result, fmt.Errorf("project ID not set in credentials")
+	}
+
+	if !options.AllowNonEmpty {
+		if err := checkEmpty(client, projectID); err != nil {
+			return result, err
+		}
+	}
+
+	for _, e := range b.Environments {
+		request := models.CreateEnvironmentRequest{
+			EnvironmentID: e.ID,
+			Name:          e.Name,
+			Type:          e.Type,
+			Tags:          e.Tags,
+		}
+		if e.Description != "" {
+			description := e.Description
+			request.Description = &description
+		}
+		created, err := client.Environments().Create(e.Name, request)
+		if err != nil {
+			return result, fmt.Errorf("failed to restore environment %s: %w", e.Name, err)
+		}
+		result.Mapping.Environments[e.ID] = e.ID
+		if created.EnvironmentID != "" {
+			result.Mapping.Environments[e.ID] = created.EnvironmentID
+		}
+	}
+
+	for _, i := range b.Infras {
+		if id, ok := options.InfraMapping[i.ID]; ok {
+			result.Mapping.Infras[i.ID] = id
+			continue
+		}
+
+		environmentID, ok := result.Mapping.Environments[i.EnvironmentID]
+		if !ok {
+			environmentID = i.EnvironmentID
+		}
+		id, err := client.Infrastructure().Create(i.Name, types.Infra{
+			InfraName:      i.Name,
+			Mode:           i.Scope,
+			Description:    i.Description,
+			PlatformName:   i.PlatformName,
+			EnvironmentID:  environmentID,
+			Namespace:      i.Namespace,
+			ServiceAccount: i.ServiceAccount,
+			NsExists:       i.NamespaceExists,
+			SAExists:       i.ServiceAccountExists,
+		})
+		if err != nil {
+			return result, fmt.Errorf("failed to restore infra %s: %w", i.Name, err)
+		}
+		result.Mapping.Infras[i.ID] = id
+		result.RegisteredInfras = append(result.RegisteredInfras, id)
+	}
+
+	for _, h := range b.ChaosHubs {
+		hub, err := restoreHub(client, h)
+		if err != nil {
+			return result, fmt.Errorf("failed to restore chaos hub %s: %w", h.Name, err)
+		}
+		result.Mapping.ChaosHubs[h.ID] = hub.ID
+	}
+
+	for _, r := range b.ImageRegistries {
+		request := models.ImageRegistryInput{
+			IsDefault:         r.IsDefault,
+			ImageRegistryName: r.Name,
+			ImageRepoName:     r.RepoName,
+			ImageRegistryType: r.Type,
+			SecretName:        optional(r.SecretName),
+			SecretNamespace:   optional(r.SecretNamespace),
+			EnableRegistry:    &r.Enabled,
+		}
+		created, err := client.ImageRegistries().Create(request)
+		if err != nil {
+			return result, fmt.Errorf("failed to restore image registry %s: %w", r.Name, err)
+		}
+		result.Mapping.Registries[r.ID] = created.ImageRegistryID
+	}
+
+	imported, err := client.Experiments().Import(b.Experiments, sdk.ImportOptions{
+		InfraMapping: result.Mapping.Infras,
+		Conflict:     sdk.ConflictSkip,
+	})
+	result.Experiments = imported
+	if err != nil {
+		return result, fmt.Errorf("failed to restore experiments: %w", err)
+	}
+
+	sourceIDs := make(map[string]string, len(b.Experiments.Experiments))
+	for _, e := range b.Experiments.Experiments {
+		sourceIDs[e.Name] = e.ID
+	}
+	for _, e := range imported.Experiments {
+		if e.Err == nil {
+			result.Mapping.Experiments[sourceIDs[e.Name]] = e.TargetID
+		}
+	}
+	if failed := imported.Failed(); len(failed) > 0 {
+		return result, fmt.Errorf("failed to restore %d of %d experiments", len(failed), len(imported.Experiments))
+	}
+
+	return result, nil
+}
+
+// checkEmpty refuses to restore into a project which already has resources
+func checkEmpty(client sdk.Client, projectID string) error {
+	var found []string
+
+	environments, err := client.Environments().List()
+	if err != nil {
+		return err
+	}
+	if n := len(environments.Environments); n > 0 {
+		found = append(found, fmt.Sprintf("%d environments", n))
+	}
+
+	infras, err := client.Infrastructure().List()
+	if err != nil {
+		return err
+	}
+	if n := len(infras.Infras); n > 0 {
+		found = append(found, fmt.Sprintf("%d infras", n))
+	}
+
+	experiments, err := client.Experiments().Select(sdk.ExperimentSelector{})
+	if err != nil {
+		return err
+	}
+	if n := len(experiments); n > 0 {
+		found = append(found, fmt.Sprintf("%d experiments", n))
+	}
+
+	probes, err := client.Probes().List(projectID)
+	if err != nil {
+		return err
+	}
+	if n := len(probes); n > 0 {
+		found = append(found, fmt.Sprintf("%d probes", n))
+	}
+
+	if len(found) > 0 {
+		return fmt.Errorf("project %s is not empty (%s), set AllowNonEmpty to restore anyway", projectID, strings.Join(found, ", "))
+	}
+	return nil
+}
+
+// restoreHub connects a backed up ChaosHub to the project
+func restoreHub(client sdk.Client, h ChaosHub) (models.ChaosHub, error) {
+	if h.HubType == models.HubTypeRemote {
+		return client.ChaosHubs().CreateRemote(models.CreateRemoteChaosHub{
+			Name:        h.Name,
+			Tags:        h.Tags,
+			Description: optional(h.Description),
+			RepoURL:     h.RepoURL,
+			RemoteHub:   h.RemoteHub,
+		})
+	}
+
+	return client.ChaosHubs().Create(models.CreateChaosHubRequest{
+		Name:          h.Name,
+		Tags:          h.Tags,
+		Description:   optional(h.Description),
+		RepoURL:       h.RepoURL,
+		RepoBranch:    h.RepoBranch,
+		RemoteHub:     h.RemoteHub,
+		IsPrivate:     h.IsPrivate,
+		AuthType:      authType(h),
+		Token:         optional(h.Token),
+		UserName:      optional(h.UserName),
+		Password:      optional(h.Password),
+		SSHPrivateKey: optional(h.SSHPrivateKey),
+		SSHPublicKey:  optional(h.SSHPublicKey),
+	})
+}
+
+// authType falls back to no authentication when the backup holds no credentials
+func authType(h ChaosHub) models.AuthType {
+	if h.AuthType == "" || (h.Token == "" && h.Password == "" && h.SSHPrivateKey == "") {
+		return models.AuthTypeNone
+	}
+	return h.AuthType
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,293 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package bundle defines a portable format for chaos experiments and the probes
+// they reference, so that suites can be moved between ChaosCenter instances and
+// versioned in git.
+//
+// A bundle is stored either as a directory or as a gzipped tarball with the
+// same layout:
+//
+//	bundle.yaml                 index with the metadata of every experiment
+//	experiments/<name>.json     experiment manifests
+//	probes/<name>.json          probe definitions
+package bundle
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/probe"
+	"gopkg.in/yaml.v2"
+)
+
+// Version is the bundle format version written by this package
+const Version = "v1"
+
+const indexFile = "bundle.yaml"
+
+// Bundle is a set of experiments along with the probes they reference
+type Bundle struct {
+	Version         string
+	ExportedAt      time.Time
+	SourceProjectID string
+	Experiments     []Experiment
+	Probes          []probe.ProbeRequest
+}
+
+// Experiment is an exported experiment
+type Experiment struct {
+	ID          string
+	Name        string
+	Description string
+	Tags        []string
+	// CronSyntax is the schedule of cron experiments, empty otherwise
+	CronSyntax string
+	// InfraID and InfraName identify the chaos infrastructure the experiment ran on in the source project
+	InfraID   string
+	InfraName string
+	Manifest  string
+}
+
+// Probe returns the bundled probe with the given name
+func (b Bundle) Probe(name string) (probe.ProbeRequest, bool) {
+	for _, p := range b.Probes {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return probe.ProbeRequest{}, false
+}
+
+// Validate checks that the bundle can be written and imported
+func (b Bundle) Validate() error {
+	if b.Version != Version {
+		return fmt.Errorf("unsupported bundle version %q, expected %q", b.Version, Version)
+	}
+
+	names := make(map[string]bool, len(b.Experiments))
+	for i, e := range b.Experiments {
+		if e.Name == "" {
+			return fmt.Errorf("experiment %d has no name", i)
+		}
+		if names[e.Name] {
+			return fmt.Errorf("duplicate experiment %q", e.Name)
+		}
+		names[e.Name] = true
+		if strings.TrimSpace(e.Manifest) == "" {
+			return fmt.Errorf("experiment %q has no manifest", e.Name)
+		}
+	}
+
+	probes := make(map[string]bool, len(b.Probes))
+	for i, p := range b.Probes {
+		if p.Name == "" {
+			return fmt.Errorf("probe %d has no name", i)
+		}
+		if probes[p.Name] {
+			return fmt.Errorf("duplicate probe %q", p.Name)
+		}
+		probes[p.Name] = true
+	}
+	return nil
+}
+
+// index is the layout of bundle.yaml
+type index struct {
+	Version         string       `yaml:"version"`
+	ExportedAt      time.Time    `yaml:"exportedAt"`
+	SourceProjectID string       `yaml:"sourceProjectID,omitempty"`
+	Experiments     []indexEntry `yaml:"experiments"`
+	Probes          []string     `yaml:"probes,omitempty"`
+}
+
+type indexEntry struct {
+	ID          string   `yaml:"id,omitempty"`
+	Name        string   `yaml:"name"`
+	Description string   `yaml:"description,omitempty"`
+	Tags        []string `yaml:"tags,omitempty"`
+	CronSyntax  string   `yaml:"cronSyntax,omitempty"`
+	InfraID     string   `yaml:"infraID,omitempty"`
+	InfraName   string   `yaml:"infraName,omitempty"`
+	Manifest    string   `yaml:"manifest"`
+}
+
+var unsafeChars = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+// fileName turns a resource name into a file name which is unique within taken
+func fileName(name string, taken map[string]bool) string {
+	base := strings.Trim(unsafeChars.ReplaceAllString(name, "-"), "-.")
+	if base == "" {
+		base = "unnamed"
+	}
+	candidate := base
+	for i := 2; taken[candidate]; i++ {
+		candidate = fmt.Sprintf("%s-%d", base, i)
+	}
+	taken[candidate] = true
+	return candidate
+}
+
+// Encode lays the bundle out as files keyed by their slash separated path,
+// along with the order in which they should be written
+func Encode(b Bundle) (map[string][]byte, []string, error) {
+	if err := b.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	files := map[string][]byte{}
+
+	idx := index{
+		Version:         b.Version,
+		ExportedAt:      b.ExportedAt.UTC(),
+		SourceProjectID: b.SourceProjectID,
+	}
+
+	taken := map[string]bool{}
+	var manifests []string
+	for _, e := range b.Experiments {
+		file := path.Join("experiments", fileName(e.Name, taken)+".json")
+		data, err := indentJSON(e.Manifest)
+		if err != nil {
+			return nil, nil, fmt.Errorf("experiment %q: %w", e.Name, err)
+		}
+		manifests = append(manifests, file)
+		files[file] = data
+		idx.Experiments = append(idx.Experiments, indexEntry{
+			ID:          e.ID,
+			Name:        e.Name,
+			Description: e.Description,
+			Tags:        e.Tags,
+			CronSyntax:  e.CronSyntax,
+			InfraID:     e.InfraID,
+			InfraName:   e.InfraName,
+			Manifest:    file,
+		})
+	}
+
+	taken = map[string]bool{}
+	var probes []string
+	for _, p := range b.Probes {
+		file := path.Join("probes", fileName(p.Name, taken)+".json")
+		data, err := json.MarshalIndent(p, "", "  ")
+		if err != nil {
+			return nil, nil, fmt.Errorf("probe %q: %w", p.Name, err)
+		}
+		probes = append(probes, file)
+		files[file] = append(data, '\n')
+		idx.Probes = append(idx.Probes, file)
+	}
+
+	data, err := yaml.Marshal(idx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal bundle index: %v", err)
+	}
+	files[indexFile] = data
+
+	order := append([]string{indexFile}, manifests...)
+	order = append(order, probes...)
+
+	return files, order, nil
+}
+
+// Decode rebuilds a bundle from its files, read is called with slash separated paths
+func Decode(read func(name string) ([]byte, error)) (Bundle, error) {
+	data, err := read(indexFile)
+	if err != nil {
+		return Bundle{}, fmt.Errorf("failed to read bundle index: %w", err)
+	}
+
+	var idx index
+	if err := yaml.UnmarshalStrict(data, &idx); err != nil {
+		return Bundle{}, fmt.Errorf("invalid bundle index: %v", err)
+	}
+
+	b := Bundle{
+		Version:         idx.Version,
+		ExportedAt:      idx.ExportedAt,
+		SourceProjectID: idx.SourceProjectID,
+	}
+
+	for _, entry := range idx.Experiments {
+		file, err := cleanPath(entry.Manifest)
+		if err != nil {
+			return Bundle{}, fmt.Errorf("experiment %q: %w", entry.Name, err)
+		}
+		manifest, err := read(file)
+		if err != nil {
+			return Bundle{}, fmt.Errorf("experiment %q: failed to read manifest: %w", entry.Name, err)
+		}
+		b.Experiments = append(b.Experiments, Experiment{
+			ID:          entry.ID,
+			Name:        entry.Name,
+			Description: entry.Description,
+			Tags:        entry.Tags,
+			CronSyntax:  entry.CronSyntax,
+			InfraID:     entry.InfraID,
+			InfraName:   entry.InfraName,
+			Manifest:    string(manifest),
+		})
+	}
+
+	for _, name := range idx.Probes {
+		file, err := cleanPath(name)
+		if err != nil {
+			return Bundle{}, fmt.Errorf("probe %s: %w", name, err)
+		}
+		data, err := read(file)
+		if err != nil {
+			return Bundle{}, fmt.Errorf("failed to read probe %s: %w", name, err)
+		}
+		var p probe.ProbeRequest
+		if err := json.Unmarshal(data, &p); err != nil {
+			return Bundle{}, fmt.Errorf("invalid probe %s: %v", name, err)
+		}
+		b.Probes = append(b.Probes, p)
+	}
+
+	if err := b.Validate(); err != nil {
+		return Bundle{}, err
+	}
+	return b, nil
+}
+
+// cleanPath rejects paths which would escape the bundle
+func cleanPath(name string) (string, error) {
+	cleaned := path.Clean(name)
+	if name == "" || path.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", fmt.Errorf("invalid path %q in bundle", name)
+	}
+	return cleaned, nil
+}
+
+// indentJSON pretty prints a JSON manifest so that it diffs well in git.
+// Manifests which are not JSON are kept as they are.
+func indentJSON(manifest string) ([]byte, error) {
+	if strings.TrimSpace(manifest) == "" {
+		return nil, fmt.Errorf("manifest cannot be empty")
+	}
+	var out bytes.Buffer
+	if err := json.Indent(&out, []byte(manifest), "", "  "); err != nil {
+		return []byte(manifest), nil
+	}
+	out.WriteByte('\n')
+	return out.Bytes(), nil
+}
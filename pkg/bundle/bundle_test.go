@@ -0,0 +1,188 @@
+package bundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/probe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testBundle() Bundle {
+	description := "checks the frontend"
+	return Bundle{
+		Version:         Version,
+		ExportedAt:      time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC),
+		SourceProjectID: "project-1",
+		Experiments: []Experiment{
+			{
+				ID:          "exp-1",
+				Name:        "pod-delete",
+				Description: "deletes pods",
+				Tags:        []string{"smoke"},
+				InfraID:     "infra-1",
+				InfraName:   "staging",
+				Manifest:    `{"kind":"Workflow","metadata":{"name":"pod-delete"}}`,
+			},
+			{
+				ID:         "exp-2",
+				Name:       "nightly/cpu hog",
+				CronSyntax: "0 2 * * *",
+				Manifest:   `{"kind":"CronWorkflow"}`,
+			},
+		},
+		Probes: []probe.ProbeRequest{
+			{
+				Name:               "frontend-up",
+				Description:        &description,
+				Type:               probe.ProbeTypeHTTPProbe,
+				InfrastructureType: probe.InfrastructureTypeKubernetes,
+				KubernetesHTTPProperties: &probe.KubernetesHTTPProbeRequest{
+					ProbeTimeout: "5s",
+					Interval:     "2s",
+					URL:          "http://frontend",
+					Method:       &probe.Method{Get: &probe.GetMethod{ResponseCode: "200", Criteria: "=="}},
+				},
+			},
+		},
+	}
+}
+
+func TestDirRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	b := testBundle()
+
+	require.NoError(t, Save(b, dir))
+	assert.FileExists(t, filepath.Join(dir, "bundle.yaml"))
+	assert.FileExists(t, filepath.Join(dir, "experiments", "pod-delete.json"))
+	assert.FileExists(t, filepath.Join(dir, "experiments", "nightly-cpu-hog.json"))
+	assert.FileExists(t, filepath.Join(dir, "probes", "frontend-up.json"))
+
+	got, err := Open(dir)
+	require.NoError(t, err)
+	assertSameBundle(t, b, got)
+}
+
+func TestWriteDirRemovesStaleFiles(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, WriteDir(testBundle(), dir))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "experiments", "README.md"), []byte("notes"), 0o644))
+
+	b := testBundle()
+	b.Experiments = b.Experiments[:1]
+	b.Probes = nil
+	require.NoError(t, WriteDir(b, dir))
+
+	assert.FileExists(t, filepath.Join(dir, "experiments", "pod-delete.json"))
+	assert.NoFileExists(t, filepath.Join(dir, "experiments", "nightly-cpu-hog.json"))
+	assert.NoFileExists(t, filepath.Join(dir, "probes", "frontend-up.json"))
+	assert.FileExists(t, filepath.Join(dir, "experiments", "README.md"), "files which are not part of a bundle are kept")
+
+	got, err := ReadDir(dir)
+	require.NoError(t, err)
+	assertSameBundle(t, b, got)
+}
+
+func TestArchiveRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "suite.tar.gz")
+	b := testBundle()
+
+	require.NoError(t, Save(b, path))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.False(t, info.IsDir())
+
+	got, err := Open(path)
+	require.NoError(t, err)
+	assertSameBundle(t, b, got)
+}
+
+func TestDuplicateFileNames(t *testing.T) {
+	b := testBundle()
+	b.Experiments[1].Name = "pod delete"
+
+	dir := t.TempDir()
+	require.NoError(t, WriteDir(b, dir))
+	assert.FileExists(t, filepath.Join(dir, "experiments", "pod-delete-2.json"))
+
+	got, err := ReadDir(dir)
+	require.NoError(t, err)
+	assert.Equal(t, "pod delete", got.Experiments[1].Name)
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Bundle)
+		err    string
+	}{
+		{name: "valid", modify: func(*Bundle) {}},
+		{name: "version", modify: func(b *Bundle) { b.Version = "v0" }, err: "unsupported bundle version"},
+		{name: "unnamed experiment", modify: func(b *Bundle) { b.Experiments[0].Name = "" }, err: "has no name"},
+		{name: "duplicate experiment", modify: func(b *Bundle) { b.Experiments[1].Name = "pod-delete" }, err: "duplicate experiment"},
+		{name: "empty manifest", modify: func(b *Bundle) { b.Experiments[0].Manifest = " " }, err: "has no manifest"},
+		{name: "duplicate probe", modify: func(b *Bundle) { b.Probes = append(b.Probes, b.Probes[0]) }, err: "duplicate probe"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := testBundle()
+			tt.modify(&b)
+			err := b.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestReadArchiveRejectsEscapingPaths(t *testing.T) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	index := []byte("version: v1\nexperiments:\n- name: evil\n  manifest: ../../etc/passwd\n")
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "bundle.yaml", Mode: 0o644, Size: int64(len(index))}))
+	_, err := tw.Write(index)
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+
+	_, err = ReadArchive(&buf)
+	assert.ErrorContains(t, err, "invalid path")
+}
+
+func TestProbe(t *testing.T) {
+	b := testBundle()
+
+	p, ok := b.Probe("frontend-up")
+	assert.True(t, ok)
+	assert.Equal(t, probe.ProbeTypeHTTPProbe, p.Type)
+
+	_, ok = b.Probe("missing")
+	assert.False(t, ok)
+}
+
+func assertSameBundle(t *testing.T, want, got Bundle) {
+	t.Helper()
+
+	assert.Equal(t, want.Version, got.Version)
+	assert.True(t, want.ExportedAt.Equal(got.ExportedAt))
+	assert.Equal(t, want.SourceProjectID, got.SourceProjectID)
+	assert.Equal(t, want.Probes, got.Probes)
+
+	require.Len(t, got.Experiments, len(want.Experiments))
+	for i := range want.Experiments {
+		w, g := want.Experiments[i], got.Experiments[i]
+		assert.JSONEq(t, w.Manifest, g.Manifest)
+		w.Manifest, g.Manifest = "", ""
+		assert.Equal(t, w, g)
+	}
+}
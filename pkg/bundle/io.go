@@ -0,0 +1,225 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package bundle
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// maxFileSize bounds the size of a single file read from an archive
+const maxFileSize = 64 << 20
+
+// Save writes the bundle to path, as a gzipped tarball when the path ends with
+// .tar.gz or .tgz and as a directory otherwise
+func Save(b Bundle, path string) error {
+	if isArchive(path) {
+		f, err := os.Create(path)
+		if err != nil {
+			return fmt.Errorf("failed to create bundle archive: %w", err)
+		}
+		if err := WriteArchive(b, f); err != nil {
+			f.Close()
+			return err
+		}
+		return f.Close()
+	}
+	return WriteDir(b, path)
+}
+
+// Open reads a bundle saved with Save
+func Open(path string) (Bundle, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return Bundle{}, fmt.Errorf("failed to open bundle: %w", err)
+	}
+	if info.IsDir() {
+		return ReadDir(path)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return Bundle{}, fmt.Errorf("failed to open bundle: %w", err)
+	}
+	defer f.Close()
+	return ReadArchive(f)
+}
+
+// WriteDir writes the bundle into a directory, creating it when needed.
+// Experiment and probe files of a previous export in the same directory are
+// replaced, so that resources which were removed since then disappear from
+// the directory as well. Other files are left untouched.
+func WriteDir(b Bundle, dir string) error {
+	files, order, err := Encode(b)
+	if err != nil {
+		return err
+	}
+
+	if err := removeStale(dir, files); err != nil {
+		return err
+	}
+
+	for _, name := range order {
+		target := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return fmt.Errorf("failed to create bundle directory: %w", err)
+		}
+		if err := os.WriteFile(target, files[name], 0o644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// removeStale deletes the experiment and probe files in dir which are not part of files
+func removeStale(dir string, files map[string][]byte) error {
+	for _, sub := range []string{"experiments", "probes"} {
+		entries, err := os.ReadDir(filepath.Join(dir, sub))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read bundle directory: %w", err)
+		}
+		for _, entry := range entries {
+			name := path.Join(sub, entry.Name())
+			if entry.IsDir() || filepath.Ext(name) != ".json" || files[name] != nil {
+				continue
+			}
+			if err := os.Remove(filepath.Join(dir, filepath.FromSlash(name))); err != nil {
+				return fmt.Errorf("failed to remove %s: %w", name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// ReadDir reads a bundle from a directory
+func ReadDir(dir string) (Bundle, error) {
+	return Decode(func(name string) ([]byte, error) {
+		return os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+	})
+}
+
+// WriteArchive writes the bundle as a gzipped tarball
+func WriteArchive(b Bundle, w io.Writer) error {
+	files, order, err := Encode(b)
+	if err != nil {
+		return err
+	}
+
+	modTime := b.ExportedAt
+	if modTime.IsZero() {
+		modTime = time.Now()
+	}
+	return WriteTarball(w, files, order, modTime)
+}
+
+// ReadArchive reads a bundle from a gzipped tarball
+func ReadArchive(r io.Reader) (Bundle, error) {
+	files, err := ReadTarball(r)
+	if err != nil {
+		return Bundle{}, err
+	}
+	return Decode(FileReader(files, ""))
+}
+
+// WriteTarball writes files, keyed by their slash separated path, as a gzipped
+// tarball in the given order
+func WriteTarball(w io.Writer, files map[string][]byte, order []string, modTime time.Time) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	for _, name := range order {
+		header := &tar.Header{
+			Name:    name,
+			Mode:    0o644,
+			Size:    int64(len(files[name])),
+			ModTime: modTime,
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return fmt.Errorf("failed to write %s: %w", name, err)
+		}
+		if _, err := tw.Write(files[name]); err != nil {
+			return fmt.Errorf("failed to write %s: %w", name, err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to finish archive: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("failed to finish archive: %w", err)
+	}
+	return nil
+}
+
+// ReadTarball reads the regular files of a gzipped tarball, keyed by their
+// slash separated path. Paths which would escape the archive are rejected.
+func ReadTarball(r io.Reader) (map[string][]byte, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("invalid archive: %w", err)
+	}
+	defer gz.Close()
+
+	files := map[string][]byte{}
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid archive: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		name, err := cleanPath(strings.TrimPrefix(header.Name, "./"))
+		if err != nil {
+			return nil, err
+		}
+		data, err := io.ReadAll(io.LimitReader(tr, maxFileSize+1))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", name, err)
+		}
+		if len(data) > maxFileSize {
+			return nil, fmt.Errorf("%s exceeds the maximum size of %d bytes", name, maxFileSize)
+		}
+		files[name] = data
+	}
+}
+
+// FileReader returns a reader for Decode which looks files up below prefix
+func FileReader(files map[string][]byte, prefix string) func(name string) ([]byte, error) {
+	return func(name string) ([]byte, error) {
+		data, ok := files[path.Join(prefix, name)]
+		if !ok {
+			return nil, fmt.Errorf("%s not found in archive", path.Join(prefix, name))
+		}
+		return data, nil
+	}
+}
+
+func isArchive(path string) bool {
+	return strings.HasSuffix(path, ".tar.gz") || strings.HasSuffix(path, ".tgz")
+}
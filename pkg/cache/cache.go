@@ -0,0 +1,357 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cache keeps a local, indexed copy of the experiments, chaos
+// infrastructures, environments and probes of a project, so that repeated
+// lookups do not reach ChaosCenter.
+//
+// Items returned by the cache are shared between callers and must not be modified.
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/sdk"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+// defaultResync is the default interval between two refreshes
+const defaultResync = time.Minute
+
+// Index names used by the stores
+const (
+	indexName        = "name"
+	indexTag         = "tag"
+	indexInfra       = "infra"
+	indexEnvironment = "environment"
+	indexType        = "type"
+)
+
+// Options configures a Cache
+type Options struct {
+	// Resync is the interval between two full refreshes, defaults to one minute
+	Resync time.Duration
+
+	// WatchRuns additionally refreshes experiments whenever a run is created or
+	// completes, so that their recent run details stay current between resyncs
+	WatchRuns bool
+
+	// WatchInterval is the poll interval of the run watch, defaults to the watch default
+	WatchInterval time.Duration
+}
+
+// ResourceStats describes the state of the cache for one kind of resource
+type ResourceStats struct {
+	Items     int
+	Hits      int64
+	Misses    int64
+	LastSync  time.Time
+	LastError error
+}
+
+// Stats describes the state of the cache
+type Stats struct {
+	Experiments  ResourceStats
+	Infras       ResourceStats
+	Environments ResourceStats
+	Probes       ResourceStats
+}
+
+// Cache is a shared, thread-safe cache of project resources
+type Cache struct {
+	client  sdk.Client
+	options Options
+
+	experiments  *store[*models.Experiment]
+	infras       *store[*models.Infra]
+	environments *store[*models.Environment]
+	probes       *store[*models.Probe]
+
+	startOnce sync.Once
+}
+
+// New creates an empty cache for the project of the client. Call Start or Sync to fill it.
+func New(client sdk.Client, options Options) *Cache {
+	if options.Resync <= 0 {
+		options.Resync = defaultResync
+	}
+
+	return &Cache{
+		client:  client,
+		options: options,
+		experiments: newStore(func(e *models.Experiment) string { return e.ExperimentID }, map[string]indexFunc[*models.Experiment]{
+			indexName: func(e *models.Experiment) []string { return []string{e.Name} },
+			indexTag:  func(e *models.Experiment) []string { return e.Tags },
+			indexInfra: func(e *models.Experiment) []string {
+				if e.Infra == nil {
+					return nil
+				}
+				return []string{e.Infra.InfraID}
+			},
+			indexEnvironment: func(e *models.Experiment) []string {
+				if e.Infra == nil {
+					return nil
+				}
+				return []string{e.Infra.EnvironmentID}
+			},
+		}),
+		infras: newStore(func(i *models.Infra) string { return i.InfraID }, map[string]indexFunc[*models.Infra]{
+			indexName:        func(i *models.Infra) []string { return []string{i.Name} },
+			indexTag:         func(i *models.Infra) []string { return i.Tags },
+			indexEnvironment: func(i *models.Infra) []string { return []string{i.EnvironmentID} },
+		}),
+		environments: newStore(func(e *models.Environment) string { return e.EnvironmentID }, map[string]indexFunc[*models.Environment]{
+			indexName: func(e *models.Environment) []string { return []string{e.Name} },
+			indexTag:  func(e *models.Environment) []string { return e.Tags },
+		}),
+		probes: newStore(func(p *models.Probe) string { return p.Name }, map[string]indexFunc[*models.Probe]{
+			indexTag:  func(p *models.Probe) []string { return p.Tags },
+			indexType: func(p *models.Probe) []string { return []string{string(p.Type)} },
+		}),
+	}
+}
+
+// Start fills the cache and keeps it up to date in the background until the
+// context is done. The initial fill must succeed; later refresh errors are
+// reported in Stats and the previous contents are kept.
+func (c *Cache) Start(ctx context.Context) error {
+	if err := c.Sync(); err != nil {
+		return err
+	}
+
+	var watchErr error
+	c.startOnce.Do(func() {
+		var runEvents <-chan sdk.RunEvent
+		if c.options.WatchRuns {
+			runEvents, watchErr = c.client.Experiments().Watch(ctx, sdk.WatchFilter{
+				Interval:       c.options.WatchInterval,
+				IgnoreExisting: true,
+			})
+			if watchErr != nil {
+				return
+			}
+		}
+		go c.run(ctx, runEvents)
+	})
+	if watchErr != nil {
+		return fmt.Errorf("failed to watch experiment runs: %w", watchErr)
+	}
+	return nil
+}
+
+// run refreshes the cache on every resync and on run events
+func (c *Cache) run(ctx context.Context, runEvents <-chan sdk.RunEvent) {
+	ticker := time.NewTicker(c.options.Resync)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_ = c.Sync()
+		case event, ok := <-runEvents:
+			if !ok {
+				runEvents = nil
+				continue
+			}
+			if event.Type == sdk.RunCreated || event.Type == sdk.RunCompleted {
+				_ = c.SyncExperiments()
+			}
+		}
+	}
+}
+
+// Sync refreshes every kind of resource now
+func (c *Cache) Sync() error {
+	return errors.Join(
+		c.SyncExperiments(),
+		c.SyncInfras(),
+		c.SyncEnvironments(),
+		c.SyncProbes(),
+	)
+}
+
+// SyncExperiments refreshes the experiments now
+func (c *Cache) SyncExperiments() error {
+	experiments, err := c.client.Experiments().Select(sdk.ExperimentSelector{})
+	if err != nil {
+		err = fmt.Errorf("failed to refresh experiments: %w", err)
+		c.experiments.fail(err)
+		return err
+	}
+	c.experiments.replace(nonNil(experiments))
+	return nil
+}
+
+// SyncInfras refreshes the chaos infrastructures now
+func (c *Cache) SyncInfras() error {
+	response, err := c.client.Infrastructure().List()
+	if err != nil {
+		err = fmt.Errorf("failed to refresh infras: %w", err)
+		c.infras.fail(err)
+		return err
+	}
+	c.infras.replace(nonNil(response.Infras))
+	return nil
+}
+
+// SyncEnvironments refreshes the environments now
+func (c *Cache) SyncEnvironments() error {
+	response, err := c.client.Environments().List()
+	if err != nil {
+		err = fmt.Errorf("failed to refresh environments: %w", err)
+		c.environments.fail(err)
+		return err
+	}
+	c.environments.replace(nonNil(response.Environments))
+	return nil
+}
+
+// SyncProbes refreshes the probes now
+func (c *Cache) SyncProbes() error {
+	probes, err := c.client.Probes().List(c.client.Auth().GetCredentials().ProjectID)
+	if err != nil {
+		err = fmt.Errorf("failed to refresh probes: %w", err)
+		c.probes.fail(err)
+		return err
+	}
+	items := make([]*models.Probe, len(probes))
+	for i := range probes {
+		items[i] = &probes[i]
+	}
+	c.probes.replace(items)
+	return nil
+}
+
+// Experiment looks up an experiment by ID
+func (c *Cache) Experiment(id string) (*models.Experiment, bool) {
+	return c.experiments.get(id)
+}
+
+// ExperimentByName looks up an experiment by name
+func (c *Cache) ExperimentByName(name string) (*models.Experiment, bool) {
+	return c.experiments.first(indexName, name)
+}
+
+// ExperimentsByTag returns the experiments with the given tag
+func (c *Cache) ExperimentsByTag(tag string) []*models.Experiment {
+	return c.experiments.byIndex(indexTag, tag)
+}
+
+// ExperimentsByInfra returns the experiments which run on the given infra
+func (c *Cache) ExperimentsByInfra(infraID string) []*models.Experiment {
+	return c.experiments.byIndex(indexInfra, infraID)
+}
+
+// ExperimentsByEnvironment returns the experiments whose infra belongs to the given environment
+func (c *Cache) ExperimentsByEnvironment(environmentID string) []*models.Experiment {
+	return c.experiments.byIndex(indexEnvironment, environmentID)
+}
+
+// Experiments returns all cached experiments
+func (c *Cache) Experiments() []*models.Experiment {
+	return c.experiments.list()
+}
+
+// Infra looks up a chaos infrastructure by ID
+func (c *Cache) Infra(id string) (*models.Infra, bool) {
+	return c.infras.get(id)
+}
+
+// InfraByName looks up a chaos infrastructure by name
+func (c *Cache) InfraByName(name string) (*models.Infra, bool) {
+	return c.infras.first(indexName, name)
+}
+
+// InfrasByTag returns the chaos infrastructures with the given tag
+func (c *Cache) InfrasByTag(tag string) []*models.Infra {
+	return c.infras.byIndex(indexTag, tag)
+}
+
+// InfrasByEnvironment returns the chaos infrastructures of an environment
+func (c *Cache) InfrasByEnvironment(environmentID string) []*models.Infra {
+	return c.infras.byIndex(indexEnvironment, environmentID)
+}
+
+// Infras returns all cached chaos infrastructures
+func (c *Cache) Infras() []*models.Infra {
+	return c.infras.list()
+}
+
+// Environment looks up an environment by ID
+func (c *Cache) Environment(id string) (*models.Environment, bool) {
+	return c.environments.get(id)
+}
+
+// EnvironmentByName looks up an environment by name
+func (c *Cache) EnvironmentByName(name string) (*models.Environment, bool) {
+	return c.environments.first(indexName, name)
+}
+
+// EnvironmentsByTag returns the environments with the given tag
+func (c *Cache) EnvironmentsByTag(tag string) []*models.Environment {
+	return c.environments.byIndex(indexTag, tag)
+}
+
+// Environments returns all cached environments
+func (c *Cache) Environments() []*models.Environment {
+	return c.environments.list()
+}
+
+// Probe looks up a probe by name, which is its ID
+func (c *Cache) Probe(name string) (*models.Probe, bool) {
+	return c.probes.get(name)
+}
+
+// ProbesByTag returns the probes with the given tag
+func (c *Cache) ProbesByTag(tag string) []*models.Probe {
+	return c.probes.byIndex(indexTag, tag)
+}
+
+// ProbesByType returns the probes of the given type
+func (c *Cache) ProbesByType(probeType models.ProbeType) []*models.Probe {
+	return c.probes.byIndex(indexType, string(probeType))
+}
+
+// Probes returns all cached probes
+func (c *Cache) Probes() []*models.Probe {
+	return c.probes.list()
+}
+
+// Stats returns the size, hit counters and sync state of the cache
+func (c *Cache) Stats() Stats {
+	return Stats{
+		Experiments:  c.experiments.stats(),
+		Infras:       c.infras.stats(),
+		Environments: c.environments.stats(),
+		Probes:       c.probes.stats(),
+	}
+}
+
+func nonNil[T any](items []*T) []*T {
+	result := make([]*T, 0, len(items))
+	for _, item := range items {
+		if item != nil {
+			result = append(result, item)
+		}
+	}
+	return result
+}
@@ -0,0 +1,156 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/sdk"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/types"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClient struct {
+	sdk.Client
+	experiments *fakeExperiments
+	infraErr    error
+}
+
+func (c *fakeClient) Experiments() sdk.ExperimentClient        { return c.experiments }
+func (c *fakeClient) Infrastructure() sdk.InfrastructureClient { return fakeInfras{err: c.infraErr} }
+func (c *fakeClient) Environments() sdk.EnvironmentClient      { return fakeEnvironments{} }
+func (c *fakeClient) Probes() sdk.ProbeClient                  { return fakeProbes{} }
+func (c *fakeClient) Auth() sdk.AuthClient                     { return fakeAuth{} }
+
+type fakeExperiments struct {
+	sdk.ExperimentClient
+	mu     sync.Mutex
+	lists  int
+	events chan sdk.RunEvent
+}
+
+func (f *fakeExperiments) Select(sdk.ExperimentSelector) ([]*models.Experiment, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.lists++
+	return []*models.Experiment{
+		{ExperimentID: "e1", Name: "pod-delete", Tags: []string{"pods", "nightly"}, Infra: &models.Infra{InfraID: "i1", EnvironmentID: "staging"}},
+		{ExperimentID: "e2", Name: "network-loss", Tags: []string{"nightly"}, Infra: &models.Infra{InfraID: "i2", EnvironmentID: "prod"}},
+		nil,
+	}, nil
+}
+
+func (f *fakeExperiments) Watch(ctx context.Context, _ sdk.WatchFilter) (<-chan sdk.RunEvent, error) {
+	return f.events, nil
+}
+
+func (f *fakeExperiments) listCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.lists
+}
+
+type fakeInfras struct {
+	sdk.InfrastructureClient
+	err error
+}
+
+func (f fakeInfras) List() (models.ListInfraResponse, error) {
+	if f.err != nil {
+		return models.ListInfraResponse{}, f.err
+	}
+	return models.ListInfraResponse{Infras: []*models.Infra{
+		{InfraID: "i1", Name: "staging-cluster", EnvironmentID: "staging"},
+		{InfraID: "i2", Name: "prod-cluster", EnvironmentID: "prod", Tags: []string{"critical"}},
+	}}, nil
+}
+
+type fakeEnvironments struct{ sdk.EnvironmentClient }
+
+func (fakeEnvironments) List() (models.ListEnvironmentResponse, error) {
+	return models.ListEnvironmentResponse{Environments: []*models.Environment{
+		{EnvironmentID: "staging", Name: "Staging"},
+		{EnvironmentID: "prod", Name: "Production", Tags: []string{"critical"}},
+	}}, nil
+}
+
+type fakeProbes struct{ sdk.ProbeClient }
+
+func (fakeProbes) List(projectID string) ([]models.Probe, error) {
+	return []models.Probe{
+		{Name: "checkout-http", Type: models.ProbeTypeHTTPProbe, Tags: []string{"checkout"}},
+		{Name: "latency-prom", Type: models.ProbeTypePromProbe},
+	}, nil
+}
+
+type fakeAuth struct{ sdk.AuthClient }
+
+func (fakeAuth) GetCredentials() types.Credentials { return types.Credentials{ProjectID: "project"} }
+
+func TestLookups(t *testing.T) {
+	c := New(&fakeClient{experiments: &fakeExperiments{}}, Options{})
+	assert.NoError(t, c.Sync())
+
+	e, ok := c.ExperimentByName("network-loss")
+	assert.True(t, ok)
+	assert.Equal(t, "e2", e.ExperimentID)
+
+	assert.Len(t, c.ExperimentsByTag("nightly"), 2)
+	assert.Equal(t, "e1", c.ExperimentsByInfra("i1")[0].ExperimentID)
+	assert.Equal(t, "e2", c.ExperimentsByEnvironment("prod")[0].ExperimentID)
+	assert.Len(t, c.Experiments(), 2)
+
+	infra, ok := c.InfraByName("prod-cluster")
+	assert.True(t, ok)
+	assert.Equal(t, "i2", infra.InfraID)
+	assert.Len(t, c.InfrasByEnvironment("staging"), 1)
+	assert.Len(t, c.InfrasByTag("critical"), 1)
+
+	env, ok := c.EnvironmentByName("Production")
+	assert.True(t, ok)
+	assert.Equal(t, "prod", env.EnvironmentID)
+
+	_, ok = c.Probe("checkout-http")
+	assert.True(t, ok)
+	assert.Len(t, c.ProbesByType(models.ProbeTypePromProbe), 1)
+
+	_, ok = c.Experiment("missing")
+	assert.False(t, ok)
+	assert.Empty(t, c.ExperimentsByTag("missing"))
+
+	stats := c.Stats()
+	assert.Equal(t, ResourceStats{Items: 2, Hits: 4, Misses: 2, LastSync: stats.Experiments.LastSync}, stats.Experiments)
+	assert.Equal(t, int64(3), stats.Infras.Hits)
+	assert.Equal(t, int64(2), stats.Probes.Hits)
+}
+
+func TestSyncKeepsStaleDataOnError(t *testing.T) {
+	client := &fakeClient{experiments: &fakeExperiments{}}
+	c := New(client, Options{})
+	assert.NoError(t, c.Sync())
+
+	client.infraErr = errors.New("connection refused")
+	assert.ErrorContains(t, c.Sync(), "failed to refresh infras: connection refused")
+
+	_, ok := c.Infra("i1")
+	assert.True(t, ok)
+	assert.ErrorContains(t, c.Stats().Infras.LastError, "connection refused")
+	assert.NoError(t, c.Stats().Experiments.LastError)
+}
+
+func TestStartRefreshesOnRunEvents(t *testing.T) {
+	experiments := &fakeExperiments{events: make(chan sdk.RunEvent)}
+	c := New(&fakeClient{experiments: experiments}, Options{Resync: time.Hour, WatchRuns: true})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	assert.NoError(t, c.Start(ctx))
+	assert.Equal(t, 1, experiments.listCount())
+
+	experiments.events <- sdk.RunEvent{Type: sdk.PhaseChanged}
+	experiments.events <- sdk.RunEvent{Type: sdk.RunCompleted}
+	assert.Eventually(t, func() bool { return experiments.listCount() == 2 }, time.Second, time.Millisecond)
+}
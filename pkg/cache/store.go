@@ -0,0 +1,160 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cache
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// indexFunc returns the values an item is indexed under
+type indexFunc[T any] func(T) []string
+
+// store is a thread-safe set of items keyed by ID with secondary indexes
+type store[T any] struct {
+	mu       sync.RWMutex
+	key      func(T) string
+	indexers map[string]indexFunc[T]
+	items    map[string]T
+	indexes  map[string]map[string][]string
+	lastSync time.Time
+	lastErr  error
+
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+func newStore[T any](key func(T) string, indexers map[string]indexFunc[T]) *store[T] {
+	return &store[T]{
+		key:      key,
+		indexers: indexers,
+		items:    make(map[string]T),
+		indexes:  make(map[string]map[string][]string),
+	}
+}
+
+// replace swaps the contents of the store for a fresh listing
+func (s *store[T]) replace(items []T) {
+	byKey := make(map[string]T, len(items))
+	indexes := make(map[string]map[string][]string, len(s.indexers))
+	for name := range s.indexers {
+		indexes[name] = make(map[string][]string)
+	}
+
+	for _, item := range items {
+		key := s.key(item)
+		if key == "" {
+			continue
+		}
+		byKey[key] = item
+		for name, index := range s.indexers {
+			for _, value := range index(item) {
+				if value != "" {
+					indexes[name][value] = append(indexes[name][value], key)
+				}
+			}
+		}
+	}
+	for _, index := range indexes {
+		for _, keys := range index {
+			sort.Strings(keys)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.items = byKey
+	s.indexes = indexes
+	s.lastSync = time.Now()
+	s.lastErr = nil
+}
+
+// fail records a failed refresh, the previous contents are kept
+func (s *store[T]) fail(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastErr = err
+}
+
+// get looks up an item by key
+func (s *store[T]) get(key string) (T, bool) {
+	s.mu.RLock()
+	item, ok := s.items[key]
+	s.mu.RUnlock()
+	s.count(ok)
+	return item, ok
+}
+
+// byIndex returns the items indexed under value, ordered by key
+func (s *store[T]) byIndex(name string, value string) []T {
+	s.mu.RLock()
+	keys := s.indexes[name][value]
+	items := make([]T, 0, len(keys))
+	for _, key := range keys {
+		items = append(items, s.items[key])
+	}
+	s.mu.RUnlock()
+	s.count(len(items) > 0)
+	return items
+}
+
+// first returns the first item indexed under value
+func (s *store[T]) first(name string, value string) (T, bool) {
+	items := s.byIndex(name, value)
+	if len(items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return items[0], true
+}
+
+// list returns all items ordered by key
+func (s *store[T]) list() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	keys := make([]string, 0, len(s.items))
+	for key := range s.items {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	items := make([]T, 0, len(keys))
+	for _, key := range keys {
+		items = append(items, s.items[key])
+	}
+	return items
+}
+
+func (s *store[T]) count(hit bool) {
+	if hit {
+		s.hits.Add(1)
+	} else {
+		s.misses.Add(1)
+	}
+}
+
+func (s *store[T]) stats() ResourceStats {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return ResourceStats{
+		Items:     len(s.items),
+		Hits:      s.hits.Load(),
+		Misses:    s.misses.Load(),
+		LastSync:  s.lastSync,
+		LastError: s.lastErr,
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package campaign runs staged chaos campaigns, such as game days, where each
+// stage only starts once the previous one met its resiliency gate.
+//
+// A campaign is usually defined in YAML:
+//
+//	name: checkout-gameday
+//	stages:
+//	  - name: pod faults
+//	    experiments: [pod-delete-id, container-kill-id]
+//	    gate:
+//	      minResiliencyScore: 80
+//	      requiredProbes: [checkout-availability]
+//	  - name: network faults
+//	    experiments: [network-loss-id]
+//	    concurrency: 1
+package campaign
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Campaign is an ordered list of stages
+type Campaign struct {
+	Name   string  `json:"name" yaml:"name"`
+	Stages []Stage `json:"stages" yaml:"stages"`
+}
+
+// Stage is a set of experiments run together, followed by a gate
+type Stage struct {
+	Name        string   `json:"name" yaml:"name"`
+	Experiments []string `json:"experiments" yaml:"experiments"`
+
+	// Concurrency limits the experiments of the stage running at the same time, defaults to all of them
+	Concurrency int `json:"concurrency,omitempty" yaml:"concurrency,omitempty"`
+
+	// FailFast stops the remaining experiments of the stage after the first failed run
+	FailFast bool `json:"failFast,omitempty" yaml:"failFast,omitempty"`
+
+	Gate Gate `json:"gate,omitempty" yaml:"gate,omitempty"`
+}
+
+// Gate holds the thresholds a stage must meet for the campaign to proceed.
+// Every run of the stage must also have completed, with or without errors.
+type Gate struct {
+	// MinResiliencyScore is the minimum average resiliency score of the runs of the stage
+	MinResiliencyScore *float64 `json:"minResiliencyScore,omitempty" yaml:"minResiliencyScore,omitempty"`
+
+	// MinRunResiliencyScore is the minimum resiliency score of every single run of the stage
+	MinRunResiliencyScore *float64 `json:"minRunResiliencyScore,omitempty" yaml:"minRunResiliencyScore,omitempty"`
+
+	// RequiredProbes must have passed in every fault they are attached to, and be attached to at least one
+	RequiredProbes []string `json:"requiredProbes,omitempty" yaml:"requiredProbes,omitempty"`
+
+	// AllProbesPass requires every probe of every run to have passed
+	AllProbesPass bool `json:"allProbesPass,omitempty" yaml:"allProbesPass,omitempty"`
+}
+
+// Parse decodes a YAML (or JSON) campaign definition and validates it
+func Parse(data []byte) (Campaign, error) {
+	var c Campaign
+	if err := yaml.UnmarshalStrict(data, &c); err != nil {
+		return Campaign{}, fmt.Errorf("failed to parse campaign: %v", err)
+	}
+	if err := c.Validate(); err != nil {
+		return Campaign{}, err
+	}
+	return c, nil
+}
+
+// Load reads a campaign definition from a file
+func Load(path string) (Campaign, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Campaign{}, fmt.Errorf("failed to read campaign: %v", err)
+	}
+	return Parse(data)
+}
+
+// Validate checks that the campaign can be run
+func (c Campaign) Validate() error {
+	if c.Name == "" {
+		return fmt.Errorf("campaign name cannot be empty")
+	}
+	if len(c.Stages) == 0 {
+		return fmt.Errorf("campaign %s has no stages", c.Name)
+	}
+
+	names := make(map[string]bool, len(c.Stages))
+	for i, stage := range c.Stages {
+		if stage.Name == "" {
+			return fmt.Errorf("stage %d of campaign %s has no name", i, c.Name)
+		}
+		if names[stage.Name] {
+			return fmt.Errorf("stage name %q is used more than once", stage.Name)
+		}
+		names[stage.Name] = true
+
+		if len(stage.Experiments) == 0 {
+			return fmt.Errorf("stage %q has no experiments", stage.Name)
+		}
+		for _, id := range stage.Experiments {
+			if id == "" {
+				return fmt.Errorf("stage %q has an empty experiment ID", stage.Name)
+			}
+		}
+		if stage.Concurrency < 0 {
+			return fmt.Errorf("stage %q has a negative concurrency", stage.Name)
+		}
+		for _, score := range []*float64{stage.Gate.MinResiliencyScore, stage.Gate.MinRunResiliencyScore} {
+			if score != nil && (*score < 0 || *score > 100) {
+				return fmt.Errorf("stage %q has a resiliency score threshold outside [0-100]", stage.Name)
+			}
+		}
+	}
+	return nil
+}
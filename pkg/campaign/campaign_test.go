@@ -0,0 +1,235 @@
+package campaign
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/sdk"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/stretchr/testify/assert"
+)
+
+const definition = `
+name: checkout-gameday
+stages:
+  - name: pod faults
+    experiments: [pod-delete, container-kill]
+    gate:
+      minResiliencyScore: 80
+      requiredProbes: [checkout-availability]
+  - name: network faults
+    experiments: [network-loss]
+    concurrency: 1
+    failFast: true
+`
+
+func TestParse(t *testing.T) {
+	c, err := Parse([]byte(definition))
+	assert.NoError(t, err)
+	assert.Equal(t, "checkout-gameday", c.Name)
+	assert.Len(t, c.Stages, 2)
+	assert.Equal(t, 80.0, *c.Stages[0].Gate.MinResiliencyScore)
+	assert.Equal(t, []string{"checkout-availability"}, c.Stages[0].Gate.RequiredProbes)
+	assert.True(t, c.Stages[1].FailFast)
+
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{name: "unknown field", data: "name: x\nstage: []", wantErr: "failed to parse campaign"},
+		{name: "no name", data: "stages: [{name: a, experiments: [e]}]", wantErr: "campaign name cannot be empty"},
+		{name: "no stages", data: "name: x", wantErr: "has no stages"},
+		{name: "duplicate stage", data: "name: x\nstages: [{name: a, experiments: [e]}, {name: a, experiments: [e]}]", wantErr: `stage name "a" is used more than once`},
+		{name: "no experiments", data: "name: x\nstages: [{name: a}]", wantErr: `stage "a" has no experiments`},
+		{name: "score out of range", data: "name: x\nstages: [{name: a, experiments: [e], gate: {minResiliencyScore: 120}}]", wantErr: "outside [0-100]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.data))
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func score(v float64) *float64 { return &v }
+
+func TestEvaluate(t *testing.T) {
+	passed := RunRecord{
+		ExperimentID:    "a",
+		Phase:           string(models.ExperimentRunStatusCompleted),
+		ResiliencyScore: score(100),
+		Probes:          []ProbeRecord{{Fault: "pod-delete", Probe: "http", Verdict: "Passed"}},
+	}
+	degraded := RunRecord{
+		ExperimentID:    "b",
+		Phase:           string(models.ExperimentRunStatusCompletedWithError),
+		ResiliencyScore: score(50),
+		Probes:          []ProbeRecord{{Fault: "network-loss", Probe: "latency", Verdict: "Failed"}},
+	}
+
+	tests := []struct {
+		name         string
+		gate         Gate
+		runs         []RunRecord
+		wantAverage  float64
+		wantFailures []string
+	}{
+		{name: "no thresholds", runs: []RunRecord{passed, degraded}, wantAverage: 75},
+		{name: "average met", gate: Gate{MinResiliencyScore: score(75)}, runs: []RunRecord{passed, degraded}, wantAverage: 75},
+		{
+			name:         "average below",
+			gate:         Gate{MinResiliencyScore: score(80)},
+			runs:         []RunRecord{passed, degraded},
+			wantAverage:  75,
+			wantFailures: []string{"average resiliency score 75.00 is below 80.00"},
+		},
+		{
+			name:         "single run below",
+			gate:         Gate{MinRunResiliencyScore: score(60)},
+			runs:         []RunRecord{passed, degraded},
+			wantAverage:  75,
+			wantFailures: []string{"experiment b resiliency score 50.00 is below 60.00"},
+		},
+		{
+			name:         "required probes",
+			gate:         Gate{RequiredProbes: []string{"http", "latency", "dns"}},
+			runs:         []RunRecord{passed, degraded},
+			wantAverage:  75,
+			wantFailures: []string{"probe latency in fault network-loss of experiment b: Failed", "probe dns did not run"},
+		},
+		{
+			name:         "all probes",
+			gate:         Gate{AllProbesPass: true},
+			runs:         []RunRecord{passed, degraded},
+			wantAverage:  75,
+			wantFailures: []string{"probe latency in fault network-loss of experiment b: Failed"},
+		},
+		{
+			name:         "errored and skipped runs",
+			runs:         []RunRecord{passed, {ExperimentID: "c", Error: "timeout"}, {ExperimentID: "d", Skipped: true}, {ExperimentID: "e", Phase: "Stopped"}},
+			wantAverage:  100,
+			wantFailures: []string{"experiment c failed: timeout", "experiment d was skipped", "experiment e finished in phase Stopped"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			average, failures := evaluate(tt.gate, tt.runs)
+			if assert.NotNil(t, average) {
+				assert.Equal(t, tt.wantAverage, *average)
+			}
+			assert.Equal(t, tt.wantFailures, failures)
+		})
+	}
+}
+
+// fakeExperiments finishes every run immediately with the score configured for its experiment
+type fakeExperiments struct {
+	sdk.ExperimentClient
+	mu     sync.Mutex
+	scores map[string]float64
+	runs   []string
+}
+
+func (f *fakeExperiments) Run(id string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.runs = append(f.runs, id)
+	return id, nil
+}
+
+func (f *fakeExperiments) GetRunByNotifyID(notifyID string) (models.ExperimentRun, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	s := f.scores[notifyID]
+	return models.ExperimentRun{
+		ExperimentID:    notifyID,
+		ExperimentRunID: "run-" + notifyID,
+		Phase:           models.ExperimentRunStatusCompleted,
+		ResiliencyScore: &s,
+		ExecutionData:   `{"nodes": {"n": {"chaosData": {"experimentName": "pod-delete", "chaosResult": {"status": {"probeStatuses": [{"name": "checkout-availability", "status": {"verdict": "Passed"}}]}}}}}}`,
+	}, nil
+}
+
+func (f *fakeExperiments) WaitForRun(ctx context.Context, notifyID string, options sdk.WaitOptions) (models.ExperimentRun, error) {
+	if err := options.Throttle(ctx); err != nil {
+		return models.ExperimentRun{}, err
+	}
+	run, err := f.GetRunByNotifyID(notifyID)
+	if err == nil {
+		options.OnUpdate(run)
+	}
+	return run, err
+}
+
+func TestRunnerResumes(t *testing.T) {
+	c, err := Parse([]byte(definition))
+	assert.NoError(t, err)
+
+	stateFile := filepath.Join(t.TempDir(), "state.json")
+	experiments := &fakeExperiments{scores: map[string]float64{"pod-delete": 100, "container-kill": 40, "network-loss": 90}}
+	runner := NewRunner(experiments, Options{StateFile: stateFile, PollInterval: time.Millisecond})
+
+	// The first stage misses its gate, so the campaign stops there
+	report, err := runner.Run(context.Background(), c)
+	assert.NoError(t, err)
+	assert.False(t, report.Passed())
+	assert.Equal(t, StageFailed, report.Stages[0].Status)
+	assert.Equal(t, []string{"average resiliency score 70.00 is below 80.00"}, report.Stages[0].GateFailures)
+	assert.Equal(t, StagePending, report.Stages[1].Status)
+	assert.ElementsMatch(t, []string{"pod-delete", "container-kill"}, experiments.runs)
+
+	data, err := os.ReadFile(stateFile)
+	assert.NoError(t, err)
+	var saved Report
+	assert.NoError(t, json.Unmarshal(data, &saved))
+	assert.Equal(t, StageFailed, saved.Stages[0].Status)
+
+	// After the fix the failed stage is run again and the campaign proceeds
+	experiments.scores["container-kill"] = 80
+	experiments.runs = nil
+	report, err = runner.Run(context.Background(), c)
+	assert.NoError(t, err)
+	assert.True(t, report.Passed())
+	assert.Equal(t, 2, report.Stages[0].Attempts)
+	assert.Equal(t, 90.0, *report.Stages[0].ResiliencyScore)
+	assert.Equal(t, []ProbeRecord{{Fault: "pod-delete", Probe: "checkout-availability", Verdict: "Passed"}}, report.Stages[0].Runs[0].Probes)
+	assert.Contains(t, report.String(), "campaign checkout-gameday passed: 2 of 2 stage(s) passed")
+
+	// A finished campaign does not run anything again
+	experiments.runs = nil
+	report, err = runner.Run(context.Background(), c)
+	assert.NoError(t, err)
+	assert.True(t, report.Passed())
+	assert.Empty(t, experiments.runs)
+}
+
+func TestRunnerRejectsForeignState(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), "state.json")
+	assert.NoError(t, os.WriteFile(stateFile, []byte(`{"campaign": "other", "stages": []}`), 0o600))
+
+	c, err := Parse([]byte(definition))
+	assert.NoError(t, err)
+
+	_, err = NewRunner(&fakeExperiments{}, Options{StateFile: stateFile}).Run(context.Background(), c)
+	assert.ErrorContains(t, err, "belongs to campaign other")
+}
+
+func TestRunnerInterrupted(t *testing.T) {
+	c, err := Parse([]byte(definition))
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	report, err := NewRunner(&fakeExperiments{}, Options{}).Run(ctx, c)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, StagePending, report.Stages[0].Status)
+	assert.Equal(t, 1, report.Stages[0].Attempts)
+}
@@ -0,0 +1,129 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package campaign
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// StageStatus is the progress of a stage
+type StageStatus string
+
+const (
+	StagePending StageStatus = "Pending"
+	StageRunning StageStatus = "Running"
+	StagePassed  StageStatus = "Passed"
+	StageFailed  StageStatus = "Failed"
+)
+
+// ProbeRecord is the verdict of a probe within a fault of a run
+type ProbeRecord struct {
+	Fault   string `json:"fault"`
+	Probe   string `json:"probe"`
+	Verdict string `json:"verdict"`
+}
+
+// RunRecord is the outcome of a single experiment of a stage
+type RunRecord struct {
+	ExperimentID    string        `json:"experimentID"`
+	ExperimentName  string        `json:"experimentName,omitempty"`
+	ExperimentRunID string        `json:"experimentRunID,omitempty"`
+	NotifyID        string        `json:"notifyID,omitempty"`
+	Phase           string        `json:"phase,omitempty"`
+	ResiliencyScore *float64      `json:"resiliencyScore,omitempty"`
+	Probes          []ProbeRecord `json:"probes,omitempty"`
+	Skipped         bool          `json:"skipped,omitempty"`
+	Error           string        `json:"error,omitempty"`
+}
+
+// StageState is the progress and outcome of a stage
+type StageState struct {
+	Name       string      `json:"name"`
+	Status     StageStatus `json:"status"`
+	Attempts   int         `json:"attempts"`
+	StartedAt  time.Time   `json:"startedAt,omitempty"`
+	FinishedAt time.Time   `json:"finishedAt,omitempty"`
+	Runs       []RunRecord `json:"runs,omitempty"`
+
+	// ResiliencyScore is the average resiliency score of the runs of the stage
+	ResiliencyScore *float64 `json:"resiliencyScore,omitempty"`
+
+	// GateFailures lists why the stage did not pass its gate
+	GateFailures []string `json:"gateFailures,omitempty"`
+}
+
+// Report is the consolidated outcome of a campaign. It doubles as the state file contents.
+type Report struct {
+	Campaign  string       `json:"campaign"`
+	Stages    []StageState `json:"stages"`
+	UpdatedAt time.Time    `json:"updatedAt"`
+}
+
+// Passed reports whether every stage of the campaign passed
+func (r Report) Passed() bool {
+	for _, stage := range r.Stages {
+		if stage.Status != StagePassed {
+			return false
+		}
+	}
+	return len(r.Stages) > 0
+}
+
+// String renders the report for humans
+func (r Report) String() string {
+	var sb strings.Builder
+	passed := 0
+
+	fmt.Fprintf(&sb, "campaign %s\n", r.Campaign)
+	for _, stage := range r.Stages {
+		if stage.Status == StagePassed {
+			passed++
+		}
+
+		score := "-"
+		if stage.ResiliencyScore != nil {
+			score = fmt.Sprintf("%.2f", *stage.ResiliencyScore)
+		}
+		fmt.Fprintf(&sb, "stage %s: %s (attempts %d, average resiliency score %s)\n", stage.Name, stage.Status, stage.Attempts, score)
+
+		for _, run := range stage.Runs {
+			runScore := "-"
+			if run.ResiliencyScore != nil {
+				runScore = fmt.Sprintf("%.2f", *run.ResiliencyScore)
+			}
+			status := run.Phase
+			switch {
+			case run.Skipped:
+				status = "skipped"
+			case run.Error != "":
+				status = "error: " + run.Error
+			}
+			fmt.Fprintf(&sb, "  %s\t%s\t%s\t%s\n", run.ExperimentID, run.ExperimentRunID, runScore, status)
+		}
+		for _, failure := range stage.GateFailures {
+			fmt.Fprintf(&sb, "  gate: %s\n", failure)
+		}
+	}
+
+	result := "failed"
+	if r.Passed() {
+		result = "passed"
+	}
+	fmt.Fprintf(&sb, "campaign %s %s: %d of %d stage(s) passed\n", r.Campaign, result, passed, len(r.Stages))
+	return sb.String()
+}
@@ -0,0 +1,338 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package campaign
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/experiment"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/orchestrator"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/sdk"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+// probeVerdictPassed is the verdict of a probe which passed
+const probeVerdictPassed = "Passed"
+
+// Options configures a Runner
+type Options struct {
+	// StateFile is where progress is persisted after every stage. If the file
+	// exists, the campaign resumes from the first stage which has not passed.
+	// Progress is not persisted when empty.
+	StateFile string
+
+	// PollInterval is the interval between status checks of each run, defaults to 10 seconds
+	PollInterval time.Duration
+
+	// RunTimeout bounds how long a single run may take, zero waits indefinitely
+	RunTimeout time.Duration
+
+	// RequestInterval is the minimum time between two API requests, zero disables rate limiting
+	RequestInterval time.Duration
+}
+
+// Runner executes campaigns stage by stage
+type Runner struct {
+	experiments sdk.ExperimentClient
+	options     Options
+}
+
+// NewRunner creates a campaign runner for the given experiment client
+func NewRunner(experiments sdk.ExperimentClient, options Options) *Runner {
+	return &Runner{experiments: experiments, options: options}
+}
+
+// Run executes the campaign until a stage fails its gate or all stages passed.
+// A failed gate is reported in the returned report rather than as an error;
+// an error is returned for invalid campaigns, state file problems and when
+// the context is cancelled, in which case the interrupted stage is run again
+// on resume.
+func (r *Runner) Run(ctx context.Context, c Campaign) (Report, error) {
+	if err := c.Validate(); err != nil {
+		return Report{}, err
+	}
+
+	report, err := r.loadState(c)
+	if err != nil {
+		return Report{}, err
+	}
+
+	for i, stage := range c.Stages {
+		state := &report.Stages[i]
+		if state.Status == StagePassed {
+			continue
+		}
+
+		state.Status = StageRunning
+		state.Attempts++
+		state.StartedAt = time.Now()
+		state.FinishedAt = time.Time{}
+		state.Runs = nil
+		state.ResiliencyScore = nil
+		state.GateFailures = nil
+		if err := r.saveState(&report); err != nil {
+			return report, err
+		}
+
+		state.Runs = r.runStage(ctx, stage)
+		state.FinishedAt = time.Now()
+
+		if ctx.Err() != nil {
+			state.Status = StagePending
+			if err := r.saveState(&report); err != nil {
+				return report, err
+			}
+			return report, fmt.Errorf("campaign %s interrupted in stage %q: %w", c.Name, stage.Name, ctx.Err())
+		}
+
+		state.ResiliencyScore, state.GateFailures = evaluate(stage.Gate, state.Runs)
+		state.Status = StagePassed
+		if len(state.GateFailures) > 0 {
+			state.Status = StageFailed
+		}
+		if err := r.saveState(&report); err != nil {
+			return report, err
+		}
+
+		if state.Status == StageFailed {
+			break
+		}
+	}
+
+	return report, nil
+}
+
+// runStage runs every experiment of a stage and records the outcomes
+func (r *Runner) runStage(ctx context.Context, stage Stage) []RunRecord {
+	options := orchestrator.Options{
+		Concurrency:     stage.Concurrency,
+		PollInterval:    r.options.PollInterval,
+		RunTimeout:      r.options.RunTimeout,
+		RequestInterval: r.options.RequestInterval,
+	}
+	if options.Concurrency == 0 {
+		options.Concurrency = len(stage.Experiments)
+	}
+	if stage.FailFast {
+		options.Policy = orchestrator.FailFast
+	}
+
+	items := make([]orchestrator.Item, len(stage.Experiments))
+	for i, id := range stage.Experiments {
+		items[i] = orchestrator.ForExperiment(id)
+	}
+
+	// Items are validated with the campaign, so Run cannot fail
+	result, _ := orchestrator.New(r.experiments, options).Run(ctx, items)
+
+	records := make([]RunRecord, len(result.Outcomes))
+	for i, outcome := range result.Outcomes {
+		records[i] = newRunRecord(outcome)
+	}
+	return records
+}
+
+// newRunRecord captures the parts of an outcome relevant to gates and reports
+func newRunRecord(outcome orchestrator.Outcome) RunRecord {
+	record := RunRecord{
+		ExperimentID:    outcome.ExperimentID,
+		ExperimentName:  outcome.Run.ExperimentName,
+		ExperimentRunID: outcome.Run.ExperimentRunID,
+		NotifyID:        outcome.NotifyID,
+		Phase:           string(outcome.Run.Phase),
+		ResiliencyScore: outcome.Run.ResiliencyScore,
+		Skipped:         outcome.Skipped,
+	}
+	if outcome.Err != nil {
+		record.Error = outcome.Err.Error()
+	}
+
+	data, err := experiment.ParseExecutionData(outcome.Run)
+	if err != nil {
+		if record.Error == "" {
+			record.Error = err.Error()
+		}
+		return record
+	}
+	for _, node := range data.Nodes {
+		if node.ChaosData == nil || node.ChaosData.ChaosResult == nil {
+			continue
+		}
+		for _, status := range node.ChaosData.ChaosResult.Status.ProbeStatuses {
+			record.Probes = append(record.Probes, ProbeRecord{
+				Fault:   node.ChaosData.ExperimentName,
+				Probe:   status.Name,
+				Verdict: status.Status.Verdict,
+			})
+		}
+	}
+	sort.Slice(record.Probes, func(i, j int) bool {
+		if record.Probes[i].Fault != record.Probes[j].Fault {
+			return record.Probes[i].Fault < record.Probes[j].Fault
+		}
+		return record.Probes[i].Probe < record.Probes[j].Probe
+	})
+	return record
+}
+
+// evaluate applies a gate to the runs of a stage, returning the average resiliency score and the reasons the gate failed
+func evaluate(gate Gate, runs []RunRecord) (*float64, []string) {
+	var failures []string
+	var total float64
+	scored := 0
+	probeSeen := make(map[string]bool)
+
+	for _, run := range runs {
+		switch {
+		case run.Skipped:
+			failures = append(failures, fmt.Sprintf("experiment %s was skipped", run.ExperimentID))
+			continue
+		case run.Error != "":
+			failures = append(failures, fmt.Sprintf("experiment %s failed: %s", run.ExperimentID, run.Error))
+			continue
+		case run.Phase != string(models.ExperimentRunStatusCompleted) && run.Phase != string(models.ExperimentRunStatusCompletedWithError):
+			failures = append(failures, fmt.Sprintf("experiment %s finished in phase %s", run.ExperimentID, run.Phase))
+			continue
+		}
+
+		if run.ResiliencyScore != nil {
+			total += *run.ResiliencyScore
+			scored++
+		}
+		if gate.MinRunResiliencyScore != nil {
+			if run.ResiliencyScore == nil {
+				failures = append(failures, fmt.Sprintf("experiment %s reported no resiliency score", run.ExperimentID))
+			} else if *run.ResiliencyScore < *gate.MinRunResiliencyScore {
+				failures = append(failures, fmt.Sprintf("experiment %s resiliency score %.2f is below %.2f", run.ExperimentID, *run.ResiliencyScore, *gate.MinRunResiliencyScore))
+			}
+		}
+
+		for _, probe := range run.Probes {
+			probeSeen[probe.Probe] = true
+			if probe.Verdict == probeVerdictPassed {
+				continue
+			}
+			if gate.AllProbesPass || contains(gate.RequiredProbes, probe.Probe) {
+				failures = append(failures, fmt.Sprintf("probe %s in fault %s of experiment %s: %s", probe.Probe, probe.Fault, run.ExperimentID, probe.Verdict))
+			}
+		}
+	}
+
+	for _, name := range gate.RequiredProbes {
+		if !probeSeen[name] {
+			failures = append(failures, fmt.Sprintf("probe %s did not run", name))
+		}
+	}
+
+	var average *float64
+	if scored > 0 {
+		avg := total / float64(scored)
+		average = &avg
+	}
+	if gate.MinResiliencyScore != nil {
+		if average == nil {
+			failures = append(failures, "stage reported no resiliency score")
+		} else if *average < *gate.MinResiliencyScore {
+			failures = append(failures, fmt.Sprintf("average resiliency score %.2f is below %.2f", *average, *gate.MinResiliencyScore))
+		}
+	}
+
+	return average, failures
+}
+
+// loadState resumes from the state file, or starts a fresh report if there is none
+func (r *Runner) loadState(c Campaign) (Report, error) {
+	report := Report{Campaign: c.Name, Stages: make([]StageState, len(c.Stages))}
+	for i, stage := range c.Stages {
+		report.Stages[i] = StageState{Name: stage.Name, Status: StagePending}
+	}
+	if r.options.StateFile == "" {
+		return report, nil
+	}
+
+	data, err := os.ReadFile(r.options.StateFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return report, nil
+	}
+	if err != nil {
+		return Report{}, fmt.Errorf("failed to read campaign state: %v", err)
+	}
+
+	var saved Report
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return Report{}, fmt.Errorf("failed to parse campaign state %s: %v", r.options.StateFile, err)
+	}
+	if saved.Campaign != c.Name {
+		return Report{}, fmt.Errorf("state file %s belongs to campaign %s, not %s", r.options.StateFile, saved.Campaign, c.Name)
+	}
+	if len(saved.Stages) != len(c.Stages) {
+		return Report{}, fmt.Errorf("state file %s has %d stages, campaign %s has %d", r.options.StateFile, len(saved.Stages), c.Name, len(c.Stages))
+	}
+	for i, stage := range c.Stages {
+		if saved.Stages[i].Name != stage.Name {
+			return Report{}, fmt.Errorf("stage %d of state file %s is %q, campaign %s has %q", i, r.options.StateFile, saved.Stages[i].Name, c.Name, stage.Name)
+		}
+	}
+
+	return saved, nil
+}
+
+// saveState atomically writes the report to the state file
+func (r *Runner) saveState(report *Report) error {
+	report.UpdatedAt = time.Now()
+	if r.options.StateFile == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal campaign state: %v", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(r.options.StateFile), filepath.Base(r.options.StateFile)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to write campaign state: %v", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write campaign state: %v", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write campaign state: %v", err)
+	}
+	if err := os.Rename(tmp.Name(), r.options.StateFile); err != nil {
+		return fmt.Errorf("failed to write campaign state: %v", err)
+	}
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
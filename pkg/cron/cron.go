@@ -0,0 +1,280 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cron parses the standard five field cron expressions accepted by
+// Argo CronWorkflows and computes their fire times locally.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxSearchYears bounds the search for the next fire time, so that expressions
+// which can never fire (such as February 30th) terminate
+const maxSearchYears = 5
+
+// field describes the bounds and names of a cron field
+type field struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var (
+	minuteField = field{name: "minute", min: 0, max: 59}
+	hourField   = field{name: "hour", min: 0, max: 23}
+	domField    = field{name: "day of month", min: 1, max: 31}
+	monthField  = field{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	dowField = field{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// descriptors are the predefined schedules accepted in place of five fields
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Schedule is a parsed cron expression
+type Schedule struct {
+	expression string
+	minutes    uint64
+	hours      uint64
+	doms       uint64
+	months     uint64
+	dows       uint64
+	// domRestricted and dowRestricted record whether the day fields were given
+	// explicitly; when both are, a day matches if either field matches
+	domRestricted bool
+	dowRestricted bool
+	every         time.Duration
+}
+
+// Parse parses a five field cron expression (minute hour day-of-month month
+// day-of-week), a predefined descriptor such as @daily, or "@every <duration>".
+func Parse(expression string) (*Schedule, error) {
+	expr := strings.TrimSpace(expression)
+	if expr == "" {
+		return nil, fmt.Errorf("cron expression cannot be empty")
+	}
+
+	if strings.HasPrefix(expr, "@every ") {
+		every, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(expr, "@every ")))
+		if err != nil {
+			return nil, fmt.Errorf("invalid @every duration in %q: %v", expression, err)
+		}
+		if every < time.Second {
+			return nil, fmt.Errorf("invalid @every duration in %q: must be at least one second", expression)
+		}
+		return &Schedule{expression: expr, every: every}, nil
+	}
+
+	if strings.HasPrefix(expr, "@") {
+		standard, ok := descriptors[strings.ToLower(expr)]
+		if !ok {
+			return nil, fmt.Errorf("unknown cron descriptor %q", expr)
+		}
+		expr = standard
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression %q must have 5 fields, got %d", expression, len(fields))
+	}
+
+	s := &Schedule{expression: strings.TrimSpace(expression)}
+	var err error
+	if s.minutes, err = parseField(fields[0], minuteField); err != nil {
+		return nil, err
+	}
+	if s.hours, err = parseField(fields[1], hourField); err != nil {
+		return nil, err
+	}
+	if s.doms, err = parseField(fields[2], domField); err != nil {
+		return nil, err
+	}
+	if s.months, err = parseField(fields[3], monthField); err != nil {
+		return nil, err
+	}
+	if s.dows, err = parseField(fields[4], dowField); err != nil {
+		return nil, err
+	}
+
+	// Sunday may be written as 0 or 7
+	if s.dows&(1<<7) != 0 {
+		s.dows |= 1
+	}
+	s.domRestricted = fields[2] != "*" && fields[2] != "?"
+	s.dowRestricted = fields[4] != "*" && fields[4] != "?"
+
+	return s, nil
+}
+
+// Validate reports whether the expression is a valid cron expression
+func Validate(expression string) error {
+	_, err := Parse(expression)
+	return err
+}
+
+// String returns the expression the schedule was parsed from
+func (s *Schedule) String() string {
+	return s.expression
+}
+
+// Next returns the first fire time strictly after t, in the location of t.
+// The zero time is returned if the schedule never fires.
+func (s *Schedule) Next(t time.Time) time.Time {
+	if s.every > 0 {
+		return t.Truncate(time.Second).Add(s.every)
+	}
+
+	// Cron fires on whole minutes
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(maxSearchYears, 0, 0)
+
+	for t.Before(limit) {
+		if !has(s.months, int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !has(s.hours, t.Hour()) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if !has(s.minutes, t.Minute()) {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+
+	return time.Time{}
+}
+
+// NextN returns the next n fire times after t
+func (s *Schedule) NextN(t time.Time, n int) []time.Time {
+	var times []time.Time
+	for i := 0; i < n; i++ {
+		t = s.Next(t)
+		if t.IsZero() {
+			break
+		}
+		times = append(times, t)
+	}
+	return times
+}
+
+func (s *Schedule) dayMatches(t time.Time) bool {
+	domMatch := has(s.doms, t.Day())
+	dowMatch := has(s.dows, int(t.Weekday()))
+	if s.domRestricted && s.dowRestricted {
+		return domMatch || dowMatch
+	}
+	return domMatch && dowMatch
+}
+
+func has(set uint64, value int) bool {
+	return set&(1<<uint(value)) != 0
+}
+
+// parseField parses a comma separated list of values, ranges and steps into a bitset
+func parseField(expr string, f field) (uint64, error) {
+	var set uint64
+	for _, part := range strings.Split(expr, ",") {
+		bits, err := parseRange(part, f)
+		if err != nil {
+			return 0, err
+		}
+		set |= bits
+	}
+	return set, nil
+}
+
+func parseRange(expr string, f field) (uint64, error) {
+	rangePart, stepPart, hasStep := strings.Cut(expr, "/")
+
+	var start, end int
+	switch rangePart {
+	case "*", "?":
+		start, end = f.min, f.max
+	default:
+		low, high, isRange := strings.Cut(rangePart, "-")
+		var err error
+		if start, err = parseValue(low, f); err != nil {
+			return 0, err
+		}
+		end = start
+		if isRange {
+			if end, err = parseValue(high, f); err != nil {
+				return 0, err
+			}
+		} else if hasStep {
+			// "5/15" means every 15 starting at 5
+			end = f.max
+		}
+	}
+
+	if start > end {
+		return 0, fmt.Errorf("invalid %s range %q: start is after end", f.name, expr)
+	}
+
+	step := 1
+	if hasStep {
+		var err error
+		step, err = strconv.Atoi(stepPart)
+		if err != nil || step <= 0 {
+			return 0, fmt.Errorf("invalid %s step %q", f.name, stepPart)
+		}
+	}
+
+	var bits uint64
+	for v := start; v <= end; v += step {
+		bits |= 1 << uint(v)
+	}
+	return bits, nil
+}
+
+func parseValue(value string, f field) (int, error) {
+	if n, ok := f.names[strings.ToLower(value)]; ok {
+		return n, nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s value %q", f.name, value)
+	}
+	if n < f.min || n > f.max {
+		return 0, fmt.Errorf("%s value %d out of range [%d-%d]", f.name, n, f.min, f.max)
+	}
+	return n, nil
+}
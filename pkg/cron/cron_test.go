@@ -0,0 +1,134 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		wantErr    string
+	}{
+		{name: "every minute", expression: "* * * * *"},
+		{name: "steps ranges and lists", expression: "*/15 9-17 1,15 * MON-FRI"},
+		{name: "named months", expression: "0 0 1 jan,jul *"},
+		{name: "sunday as seven", expression: "0 0 * * 7"},
+		{name: "descriptor", expression: "@daily"},
+		{name: "every duration", expression: "@every 90m"},
+		{name: "empty expression", expression: " ", wantErr: "cannot be empty"},
+		{name: "too few fields", expression: "* * * *", wantErr: "must have 5 fields"},
+		{name: "minute out of range", expression: "60 * * * *", wantErr: "minute value 60 out of range"},
+		{name: "inverted range", expression: "* 10-5 * * *", wantErr: "start is after end"},
+		{name: "invalid step", expression: "*/0 * * * *", wantErr: "invalid minute step"},
+		{name: "unknown name", expression: "* * * foo *", wantErr: "invalid month value"},
+		{name: "unknown descriptor", expression: "@fortnightly", wantErr: "unknown cron descriptor"},
+		{name: "invalid every", expression: "@every soon", wantErr: "invalid @every duration"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := Parse(tt.expression)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				assert.Error(t, Validate(tt.expression))
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotNil(t, schedule)
+		})
+	}
+}
+
+func TestNextN(t *testing.T) {
+	// Wednesday
+	start := time.Date(2025, time.January, 1, 10, 7, 30, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		expression string
+		from       time.Time
+		want       []time.Time
+	}{
+		{
+			name:       "every fifteen minutes",
+			expression: "*/15 * * * *",
+			from:       start,
+			want: []time.Time{
+				time.Date(2025, time.January, 1, 10, 15, 0, 0, time.UTC),
+				time.Date(2025, time.January, 1, 10, 30, 0, 0, time.UTC),
+				time.Date(2025, time.January, 1, 10, 45, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:       "weekdays at nine",
+			expression: "0 9 * * MON-FRI",
+			from:       time.Date(2025, time.January, 3, 10, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2025, time.January, 6, 9, 0, 0, 0, time.UTC),
+				time.Date(2025, time.January, 7, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:       "day of month or day of week",
+			expression: "0 0 15 * SUN",
+			from:       start,
+			want: []time.Time{
+				time.Date(2025, time.January, 5, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, time.January, 12, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, time.January, 15, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:       "leap day",
+			expression: "0 0 29 2 *",
+			from:       start,
+			want: []time.Time{
+				time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:       "never fires",
+			expression: "0 0 30 2 *",
+			from:       start,
+			want:       nil,
+		},
+		{
+			name:       "every duration",
+			expression: "@every 2h",
+			from:       start,
+			want: []time.Time{
+				time.Date(2025, time.January, 1, 12, 7, 30, 0, time.UTC),
+				time.Date(2025, time.January, 1, 14, 7, 30, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := Parse(tt.expression)
+			assert.NoError(t, err)
+			n := len(tt.want)
+			if n == 0 {
+				n = 1
+			}
+			assert.Equal(t, tt.want, schedule.NextN(tt.from, n))
+		})
+	}
+}
+
+func TestNextKeepsLocation(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Skip("timezone database not available")
+	}
+
+	schedule, err := Parse("30 2 * * *")
+	assert.NoError(t, err)
+
+	next := schedule.Next(time.Date(2025, time.March, 1, 12, 0, 0, 0, loc))
+	assert.Equal(t, time.Date(2025, time.March, 2, 2, 30, 0, 0, loc), next)
+}
@@ -0,0 +1,190 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package litmustest runs chaos experiments from ordinary Go tests and asserts
+// on their outcome.
+//
+//	func TestCheckoutSurvivesPodDelete(t *testing.T) {
+//		h := litmustest.New(t, client, litmustest.WithTimeout(15*time.Minute))
+//		id := h.CreateExperiment(request)
+//		result := h.Run(id)
+//		result.AssertResiliencyScoreAtLeast(80)
+//		result.AssertProbesPassed("checkout-availability")
+//		result.AssertNoFaultStopped()
+//	}
+//
+// Everything created through the harness is removed when the test finishes,
+// and runs which are still in progress at that point are stopped.
+package litmustest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/probe"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/sdk"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+const (
+	defaultTimeout      = 30 * time.Minute
+	defaultPollInterval = 10 * time.Second
+)
+
+// Option configures a Harness
+type Option func(*Harness)
+
+// WithTimeout sets how long Run waits for an experiment run to finish, defaults to 30 minutes
+func WithTimeout(timeout time.Duration) Option {
+	return func(h *Harness) {
+		h.timeout = timeout
+	}
+}
+
+// WithPollInterval sets the interval between status checks of a run, defaults to 10 seconds
+func WithPollInterval(interval time.Duration) Option {
+	return func(h *Harness) {
+		h.pollInterval = interval
+	}
+}
+
+// Harness runs chaos experiments on behalf of a test
+type Harness struct {
+	t            testing.TB
+	client       sdk.Client
+	timeout      time.Duration
+	pollInterval time.Duration
+}
+
+// New creates a harness which uses the given client for the duration of the test
+func New(t testing.TB, client sdk.Client, opts ...Option) *Harness {
+	t.Helper()
+
+	if client == nil {
+		t.Fatalf("litmustest: client cannot be nil")
+	}
+
+	h := &Harness{
+		t:            t,
+		client:       client,
+		timeout:      defaultTimeout,
+		pollInterval: defaultPollInterval,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// CreateExperiment saves an experiment without running it and deletes it when the test finishes.
+// An ID is generated if the request has none. The ID of the experiment is returned.
+func (h *Harness) CreateExperiment(request models.SaveChaosExperimentRequest) string {
+	h.t.Helper()
+
+	if request.ID == "" {
+		request.ID = uuid.New().String()
+	}
+
+	if _, err := h.client.Experiments().Update(request.ID, request); err != nil {
+		h.t.Fatalf("litmustest: failed to create experiment %s: %v", request.Name, err)
+	}
+
+	id := request.ID
+	h.t.Cleanup(func() {
+		if err := h.client.Experiments().Delete(id); err != nil {
+			h.t.Errorf("litmustest: failed to delete experiment %s: %v", id, err)
+		}
+	})
+
+	return id
+}
+
+// CreateProbe creates a probe in the project of the client and deletes it when the test finishes
+func (h *Harness) CreateProbe(request probe.ProbeRequest) models.Probe {
+	h.t.Helper()
+
+	projectID := h.client.Auth().GetCredentials().ProjectID
+	created, err := h.client.Probes().Create(request, projectID)
+	if err != nil {
+		h.t.Fatalf("litmustest: failed to create probe %s: %v", request.Name, err)
+	}
+
+	name := created.Name
+	h.t.Cleanup(func() {
+		if err := h.client.Probes().Delete(projectID, name); err != nil {
+			h.t.Errorf("litmustest: failed to delete probe %s: %v", name, err)
+		}
+	})
+
+	return created
+}
+
+// Run starts an experiment and waits for the run to finish. The test fails immediately if the
+// run cannot be started or does not finish in time; in that case the run is stopped during cleanup.
+func (h *Harness) Run(experimentID string) *Result {
+	h.t.Helper()
+
+	experiments := h.client.Experiments()
+	notifyID, err := experiments.Run(experimentID)
+	if err != nil {
+		h.t.Fatalf("litmustest: failed to run experiment %s: %v", experimentID, err)
+	}
+
+	finished := false
+	var runID string
+	h.t.Cleanup(func() {
+		if finished {
+			return
+		}
+		// Only the run of this test is stopped, unless it was never observed
+		if runID == "" {
+			if err := experiments.Stop(experimentID); err != nil {
+				h.t.Errorf("litmustest: failed to stop experiment %s: %v", experimentID, err)
+			}
+			return
+		}
+		if err := experiments.StopRun(experimentID, runID); err != nil {
+			h.t.Errorf("litmustest: failed to stop run %s of experiment %s: %v", runID, experimentID, err)
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.timeout)
+	defer cancel()
+
+	run, err := experiments.WaitForRun(ctx, notifyID, sdk.WaitOptions{
+		PollInterval: h.pollInterval,
+		OnUpdate: func(run models.ExperimentRun) {
+			runID = run.ExperimentRunID
+		},
+	})
+	if err != nil {
+		h.t.Fatalf("litmustest: experiment %s did not finish: %v", experimentID, err)
+	}
+	finished = true
+
+	return NewResult(h.t, run)
+}
+
+// Stop stops all ongoing runs of an experiment
+func (h *Harness) Stop(experimentID string) {
+	h.t.Helper()
+
+	if err := h.client.Experiments().Stop(experimentID); err != nil {
+		h.t.Fatalf("litmustest: failed to stop runs of experiment %s: %v", experimentID, err)
+	}
+}
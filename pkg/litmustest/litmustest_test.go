@@ -0,0 +1,230 @@
+package litmustest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/sdk"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeT records failures instead of failing the surrounding test
+type fakeT struct {
+	testing.TB
+	errors   []string
+	fatal    bool
+	cleanups []func()
+}
+
+func (f *fakeT) Helper() {}
+
+func (f *fakeT) Errorf(format string, args ...interface{}) {
+	f.errors = append(f.errors, fmt.Sprintf(format, args...))
+}
+
+func (f *fakeT) Fatalf(format string, args ...interface{}) {
+	f.Errorf(format, args...)
+	f.fatal = true
+	runtime.Goexit()
+}
+
+// do runs fn the way the testing package runs a test, so that Fatalf stops it
+func (f *fakeT) do(fn func()) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn()
+	}()
+	<-done
+}
+
+func (f *fakeT) Cleanup(fn func()) {
+	f.cleanups = append(f.cleanups, fn)
+}
+
+func (f *fakeT) runCleanups() {
+	for i := len(f.cleanups) - 1; i >= 0; i-- {
+		f.cleanups[i]()
+	}
+}
+
+type fakeClient struct {
+	sdk.Client
+	experiments *fakeExperiments
+}
+
+func (c *fakeClient) Experiments() sdk.ExperimentClient {
+	return c.experiments
+}
+
+type fakeExperiments struct {
+	sdk.ExperimentClient
+	run      models.ExperimentRun
+	observed *models.ExperimentRun
+	waitErr  error
+	calls    []string
+}
+
+func (e *fakeExperiments) Update(id string, _ models.SaveChaosExperimentRequest) (string, error) {
+	e.calls = append(e.calls, "save "+id)
+	return "", nil
+}
+
+func (e *fakeExperiments) Delete(id string) error {
+	e.calls = append(e.calls, "delete "+id)
+	return nil
+}
+
+func (e *fakeExperiments) Run(id string) (string, error) {
+	e.calls = append(e.calls, "run "+id)
+	return "notify-1", nil
+}
+
+func (e *fakeExperiments) Stop(id string) error {
+	e.calls = append(e.calls, "stop "+id)
+	return nil
+}
+
+func (e *fakeExperiments) StopRun(id string, runID string) error {
+	e.calls = append(e.calls, "stop "+id+" "+runID)
+	return nil
+}
+
+func (e *fakeExperiments) WaitForRun(_ context.Context, notifyID string, options sdk.WaitOptions) (models.ExperimentRun, error) {
+	if e.observed != nil && options.OnUpdate != nil {
+		options.OnUpdate(*e.observed)
+	}
+	return e.run, e.waitErr
+}
+
+const executionData = `{
+	"phase": "Succeeded",
+	"nodes": {
+		"node-1": {"name": "install-chaos-faults", "type": "Pod"},
+		"node-2": {
+			"name": "pod-delete",
+			"type": "ChaosEngine",
+			"chaosData": {
+				"experimentName": "pod-delete",
+				"experimentStatus": "Completed",
+				"experimentVerdict": "Pass",
+				"chaosResult": {"status": {"probeStatuses": [
+					{"name": "checkout-availability", "mode": "Continuous", "status": {"verdict": "Passed"}},
+					{"name": "latency-slo", "mode": "Edge", "status": {"verdict": "Failed", "description": "p99 above 300ms"}}
+				]}}
+			}
+		},
+		"node-3": {
+			"name": "network-loss",
+			"type": "ChaosEngine",
+			"chaosData": {"experimentName": "network-loss", "experimentStatus": "Stopped", "experimentVerdict": "Stopped"}
+		}
+	}
+}`
+
+func intPtr(v int) *int { return &v }
+
+func testRun() models.ExperimentRun {
+	score := 50.0
+	return models.ExperimentRun{
+		ExperimentRunID: "run-1",
+		ExperimentID:    "exp-1",
+		ExperimentName:  "checkout",
+		Phase:           models.ExperimentRunStatusCompletedWithError,
+		ResiliencyScore: &score,
+		FaultsPassed:    intPtr(1),
+		FaultsStopped:   intPtr(1),
+		TotalFaults:     intPtr(2),
+		ExecutionData:   executionData,
+	}
+}
+
+func TestAssertions(t *testing.T) {
+	tests := []struct {
+		name     string
+		assert   func(*Result) bool
+		wantPass bool
+		wantMsg  string
+	}{
+		{name: "score met", assert: func(r *Result) bool { return r.AssertResiliencyScoreAtLeast(50) }, wantPass: true},
+		{name: "score below", assert: func(r *Result) bool { return r.AssertResiliencyScoreAtLeast(80) }, wantMsg: "resiliency score 50.00 is below 80.00"},
+		{name: "named probe passed", assert: func(r *Result) bool { return r.AssertProbesPassed("checkout-availability") }, wantPass: true},
+		{name: "named probe failed", assert: func(r *Result) bool { return r.AssertProbesPassed("latency-slo") }, wantMsg: "probe latency-slo in fault pod-delete: Failed p99 above 300ms"},
+		{name: "all probes", assert: func(r *Result) bool { return r.AssertProbesPassed() }, wantMsg: "latency-slo"},
+		{name: "missing probe", assert: func(r *Result) bool { return r.AssertProbesPassed("unknown") }, wantMsg: "probe unknown: not found in run"},
+		{name: "stopped fault", assert: func(r *Result) bool { return r.AssertNoFaultStopped() }, wantMsg: "faults were stopped: network-loss"},
+		{name: "phase", assert: func(r *Result) bool { return r.AssertPhase(models.ExperimentRunStatusCompleted) }, wantMsg: "run finished in phase Completed_With_Error, expected Completed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ft := &fakeT{}
+			result := NewResult(ft, testRun())
+
+			assert.Equal(t, tt.wantPass, tt.assert(result))
+			if tt.wantPass {
+				assert.Empty(t, ft.errors)
+				return
+			}
+			if assert.Len(t, ft.errors, 1) {
+				assert.Contains(t, ft.errors[0], tt.wantMsg)
+				assert.Contains(t, ft.errors[0], "run run-1 of experiment checkout (exp-1)")
+			}
+		})
+	}
+}
+
+func TestRunCleansUp(t *testing.T) {
+	t.Run("finished run is not stopped", func(t *testing.T) {
+		ft := &fakeT{}
+		experiments := &fakeExperiments{run: testRun()}
+		h := New(ft, &fakeClient{experiments: experiments})
+
+		id := h.CreateExperiment(models.SaveChaosExperimentRequest{ID: "exp-1", Name: "checkout"})
+		result := h.Run(id)
+		ft.runCleanups()
+
+		assert.False(t, ft.fatal)
+		assert.Equal(t, 50.0, result.ResiliencyScore())
+		assert.Len(t, result.Probes(), 2)
+		assert.Equal(t, []string{"save exp-1", "run exp-1", "delete exp-1"}, experiments.calls)
+	})
+
+	t.Run("unfinished run is stopped before deletion", func(t *testing.T) {
+		ft := &fakeT{}
+		experiments := &fakeExperiments{waitErr: errors.New("context deadline exceeded")}
+		h := New(ft, &fakeClient{experiments: experiments})
+
+		ft.do(func() {
+			id := h.CreateExperiment(models.SaveChaosExperimentRequest{ID: "exp-1", Name: "checkout"})
+			h.Run(id)
+		})
+		ft.runCleanups()
+
+		assert.True(t, ft.fatal)
+		assert.Equal(t, []string{"save exp-1", "run exp-1", "stop exp-1", "delete exp-1"}, experiments.calls,
+			"the experiment is stopped when its run was never observed")
+	})
+
+	t.Run("only the observed run is stopped", func(t *testing.T) {
+		ft := &fakeT{}
+		experiments := &fakeExperiments{
+			observed: &models.ExperimentRun{ExperimentRunID: "run-1", Phase: models.ExperimentRunStatusRunning},
+			waitErr:  errors.New("context deadline exceeded"),
+		}
+		h := New(ft, &fakeClient{experiments: experiments})
+
+		ft.do(func() {
+			id := h.CreateExperiment(models.SaveChaosExperimentRequest{ID: "exp-1", Name: "checkout"})
+			h.Run(id)
+		})
+		ft.runCleanups()
+
+		assert.True(t, ft.fatal)
+		assert.Equal(t, []string{"save exp-1", "run exp-1", "stop exp-1 run-1", "delete exp-1"}, experiments.calls)
+	})
+}
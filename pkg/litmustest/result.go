@@ -0,0 +1,225 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package litmustest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/experiment"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+const (
+	verdictPassed  = "Passed"
+	verdictStopped = "Stopped"
+)
+
+// ProbeVerdict is the outcome of a probe within a fault
+type ProbeVerdict struct {
+	Fault       string
+	Probe       string
+	Mode        string
+	Verdict     string
+	Description string
+}
+
+// FaultVerdict is the outcome of a single fault of a run
+type FaultVerdict struct {
+	Fault   string
+	Phase   string
+	Verdict string
+	Probes  []ProbeVerdict
+}
+
+// Result is a finished experiment run which assertions are made against
+type Result struct {
+	t      testing.TB
+	Run    models.ExperimentRun
+	Faults []FaultVerdict
+}
+
+// NewResult wraps a finished run for assertions. The test fails if its execution data cannot be parsed.
+func NewResult(t testing.TB, run models.ExperimentRun) *Result {
+	t.Helper()
+
+	r := &Result{t: t, Run: run}
+	data, err := experiment.ParseExecutionData(run)
+	if err != nil {
+		t.Errorf("litmustest: %v", err)
+		return r
+	}
+
+	for _, node := range data.Nodes {
+		if node.ChaosData == nil {
+			continue
+		}
+		fault := FaultVerdict{
+			Fault:   node.ChaosData.ExperimentName,
+			Phase:   node.ChaosData.ExperimentStatus,
+			Verdict: node.ChaosData.ExperimentVerdict,
+		}
+		if fault.Fault == "" {
+			fault.Fault = node.Name
+		}
+		if result := node.ChaosData.ChaosResult; result != nil {
+			for _, status := range result.Status.ProbeStatuses {
+				fault.Probes = append(fault.Probes, ProbeVerdict{
+					Fault:       fault.Fault,
+					Probe:       status.Name,
+					Mode:        status.Mode,
+					Verdict:     status.Status.Verdict,
+					Description: status.Status.Description,
+				})
+			}
+		}
+		r.Faults = append(r.Faults, fault)
+	}
+	sort.Slice(r.Faults, func(i, j int) bool { return r.Faults[i].Fault < r.Faults[j].Fault })
+
+	return r
+}
+
+// ResiliencyScore returns the resiliency score of the run, zero if none was reported
+func (r *Result) ResiliencyScore() float64 {
+	if r.Run.ResiliencyScore == nil {
+		return 0
+	}
+	return *r.Run.ResiliencyScore
+}
+
+// Probes returns the verdicts of all probes of the run
+func (r *Result) Probes() []ProbeVerdict {
+	var probes []ProbeVerdict
+	for _, fault := range r.Faults {
+		probes = append(probes, fault.Probes...)
+	}
+	return probes
+}
+
+// AssertPhase checks that the run finished in the given phase
+func (r *Result) AssertPhase(phase models.ExperimentRunStatus) bool {
+	r.t.Helper()
+
+	if r.Run.Phase != phase {
+		r.t.Errorf("litmustest: run finished in phase %s, expected %s\n%s", r.Run.Phase, phase, r)
+		return false
+	}
+	return true
+}
+
+// AssertResiliencyScoreAtLeast checks that the resiliency score of the run is at least min
+func (r *Result) AssertResiliencyScoreAtLeast(min float64) bool {
+	r.t.Helper()
+
+	if r.Run.ResiliencyScore == nil {
+		r.t.Errorf("litmustest: run reported no resiliency score, expected at least %.2f\n%s", min, r)
+		return false
+	}
+	if score := *r.Run.ResiliencyScore; score < min {
+		r.t.Errorf("litmustest: resiliency score %.2f is below %.2f\n%s", score, min, r)
+		return false
+	}
+	return true
+}
+
+// AssertProbesPassed checks that the named probes passed in every fault they were attached to.
+// All probes of the run are checked when no names are given.
+func (r *Result) AssertProbesPassed(names ...string) bool {
+	r.t.Helper()
+
+	wanted := make(map[string]bool, len(names))
+	for _, name := range names {
+		wanted[name] = false
+	}
+
+	var failures []string
+	for _, p := range r.Probes() {
+		if len(names) > 0 {
+			if _, ok := wanted[p.Probe]; !ok {
+				continue
+			}
+			wanted[p.Probe] = true
+		}
+		if p.Verdict != verdictPassed {
+			failures = append(failures, fmt.Sprintf("probe %s in fault %s: %s %s", p.Probe, p.Fault, p.Verdict, p.Description))
+		}
+	}
+	for _, name := range names {
+		if !wanted[name] {
+			failures = append(failures, fmt.Sprintf("probe %s: not found in run", name))
+		}
+	}
+
+	if len(failures) > 0 {
+		r.t.Errorf("litmustest: probes did not pass:\n\t%s\n%s", strings.Join(failures, "\n\t"), r)
+		return false
+	}
+	return true
+}
+
+// AssertNoFaultStopped checks that no fault of the run was stopped
+func (r *Result) AssertNoFaultStopped() bool {
+	r.t.Helper()
+
+	var stopped []string
+	for _, fault := range r.Faults {
+		if fault.Verdict == verdictStopped || fault.Phase == verdictStopped {
+			stopped = append(stopped, fault.Fault)
+		}
+	}
+	if len(stopped) == 0 && r.Run.FaultsStopped != nil && *r.Run.FaultsStopped > 0 {
+		stopped = append(stopped, fmt.Sprintf("%d fault(s)", *r.Run.FaultsStopped))
+	}
+
+	if len(stopped) > 0 {
+		r.t.Errorf("litmustest: faults were stopped: %s\n%s", strings.Join(stopped, ", "), r)
+		return false
+	}
+	return true
+}
+
+// String renders the run details included in failure messages
+func (r *Result) String() string {
+	var sb strings.Builder
+	run := r.Run
+
+	fmt.Fprintf(&sb, "run %s of experiment %s (%s)\n", run.ExperimentRunID, run.ExperimentName, run.ExperimentID)
+	fmt.Fprintf(&sb, "  phase: %s\n", run.Phase)
+	if run.ResiliencyScore != nil {
+		fmt.Fprintf(&sb, "  resiliency score: %.2f\n", *run.ResiliencyScore)
+	}
+	fmt.Fprintf(&sb, "  faults: %d passed, %d failed, %d stopped, %d awaited, %d n/a of %d\n",
+		deref(run.FaultsPassed), deref(run.FaultsFailed), deref(run.FaultsStopped),
+		deref(run.FaultsAwaited), deref(run.FaultsNa), deref(run.TotalFaults))
+
+	for _, fault := range r.Faults {
+		fmt.Fprintf(&sb, "  fault %s: %s %s\n", fault.Fault, fault.Phase, fault.Verdict)
+		for _, p := range fault.Probes {
+			fmt.Fprintf(&sb, "    probe %s (%s): %s %s\n", p.Probe, p.Mode, p.Verdict, p.Description)
+		}
+	}
+	return strings.TrimRight(sb.String(), "\n")
+}
+
+func deref(v *int) int {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
@@ -0,0 +1,321 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// unifiedContext is the number of unchanged lines shown around each hunk
+const unifiedContext = 3
+
+// Operation is a single RFC 6902 JSON patch operation
+type Operation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// MarshalJSON always emits the value for add and replace operations, even when it is null
+func (o Operation) MarshalJSON() ([]byte, error) {
+	if o.Op == "remove" {
+		return json.Marshal(struct {
+			Op   string `json:"op"`
+			Path string `json:"path"`
+		}{o.Op, o.Path})
+	}
+	return json.Marshal(struct {
+		Op    string      `json:"op"`
+		Path  string      `json:"path"`
+		Value interface{} `json:"value"`
+	}{o.Op, o.Path, o.Value})
+}
+
+// Diff is the structural difference between two manifests
+type Diff struct {
+	Operations []Operation
+	fromLines  []string
+	toLines    []string
+}
+
+// Compare computes the structural difference between two manifests, which may
+// each be JSON or YAML.
+func Compare(from, to string) (Diff, error) {
+	fromDoc, err := Parse(from)
+	if err != nil {
+		return Diff{}, fmt.Errorf("invalid source manifest: %w", err)
+	}
+
+	toDoc, err := Parse(to)
+	if err != nil {
+		return Diff{}, fmt.Errorf("invalid target manifest: %w", err)
+	}
+
+	fromLines, err := render(fromDoc)
+	if err != nil {
+		return Diff{}, err
+	}
+
+	toLines, err := render(toDoc)
+	if err != nil {
+		return Diff{}, err
+	}
+
+	var ops []Operation
+	compareValues("", fromDoc, toDoc, &ops)
+
+	return Diff{
+		Operations: ops,
+		fromLines:  fromLines,
+		toLines:    toLines,
+	}, nil
+}
+
+// Empty reports whether both manifests are structurally identical
+func (d Diff) Empty() bool {
+	return len(d.Operations) == 0
+}
+
+// JSONPatch renders the difference as an RFC 6902 JSON patch document
+func (d Diff) JSONPatch() ([]byte, error) {
+	ops := d.Operations
+	if ops == nil {
+		ops = []Operation{}
+	}
+	return json.MarshalIndent(ops, "", "  ")
+}
+
+// Unified renders the difference as a unified text diff of the canonical YAML
+// form of both manifests. Multi-line values such as embedded ChaosEngine specs
+// are rendered as literal blocks so that changes inside them show line by line.
+func (d Diff) Unified(fromLabel, toLabel string) string {
+	if d.Empty() {
+		return ""
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", fromLabel, toLabel)
+	for _, h := range hunks(d.fromLines, d.toLines) {
+		sb.WriteString(h)
+	}
+	return sb.String()
+}
+
+// render converts a parsed manifest into canonical YAML lines
+func render(doc interface{}) ([]string, error) {
+	out, err := yaml.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render manifest: %v", err)
+	}
+	return strings.Split(strings.TrimSuffix(string(out), "\n"), "\n"), nil
+}
+
+func compareValues(path string, from, to interface{}, ops *[]Operation) {
+	switch f := from.(type) {
+	case map[string]interface{}:
+		t, ok := to.(map[string]interface{})
+		if !ok {
+			*ops = append(*ops, Operation{Op: "replace", Path: path, Value: to})
+			return
+		}
+
+		keys := make([]string, 0, len(f)+len(t))
+		for k := range f {
+			keys = append(keys, k)
+		}
+		for k := range t {
+			if _, exists := f[k]; !exists {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			child := path + "/" + escapePointer(k)
+			fv, inFrom := f[k]
+			tv, inTo := t[k]
+			switch {
+			case !inTo:
+				*ops = append(*ops, Operation{Op: "remove", Path: child})
+			case !inFrom:
+				*ops = append(*ops, Operation{Op: "add", Path: child, Value: tv})
+			default:
+				compareValues(child, fv, tv, ops)
+			}
+		}
+	case []interface{}:
+		t, ok := to.([]interface{})
+		if !ok {
+			*ops = append(*ops, Operation{Op: "replace", Path: path, Value: to})
+			return
+		}
+
+		common := len(f)
+		if len(t) < common {
+			common = len(t)
+		}
+		for i := 0; i < common; i++ {
+			compareValues(fmt.Sprintf("%s/%d", path, i), f[i], t[i], ops)
+		}
+		for i := common; i < len(t); i++ {
+			*ops = append(*ops, Operation{Op: "add", Path: fmt.Sprintf("%s/%d", path, i), Value: t[i]})
+		}
+		// Remove from the end so that earlier indices stay valid while the patch is applied
+		for i := len(f) - 1; i >= common; i-- {
+			*ops = append(*ops, Operation{Op: "remove", Path: fmt.Sprintf("%s/%d", path, i)})
+		}
+	default:
+		if !reflect.DeepEqual(from, to) {
+			*ops = append(*ops, Operation{Op: "replace", Path: path, Value: to})
+		}
+	}
+}
+
+// escapePointer escapes a key for use in a JSON pointer (RFC 6901)
+func escapePointer(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
+
+// lineEdit is a single line of a line-based edit script
+type lineEdit struct {
+	kind byte // ' ', '-' or '+'
+	text string
+}
+
+// editScript computes a minimal line edit script using the longest common subsequence
+func editScript(a, b []string) []lineEdit {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var edits []lineEdit
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			edits = append(edits, lineEdit{' ', a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			edits = append(edits, lineEdit{'-', a[i]})
+			i++
+		default:
+			edits = append(edits, lineEdit{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		edits = append(edits, lineEdit{'-', a[i]})
+	}
+	for ; j < len(b); j++ {
+		edits = append(edits, lineEdit{'+', b[j]})
+	}
+	return edits
+}
+
+// hunks groups an edit script into unified diff hunks
+func hunks(a, b []string) []string {
+	edits := editScript(a, b)
+
+	var result []string
+	for start := 0; start < len(edits); {
+		// Find the next change
+		for start < len(edits) && edits[start].kind == ' ' {
+			start++
+		}
+		if start >= len(edits) {
+			break
+		}
+
+		// Extend the hunk while changes are within twice the context of each other
+		end := start
+		for k := start; k < len(edits); k++ {
+			if edits[k].kind != ' ' {
+				end = k
+				continue
+			}
+			if k-end > 2*unifiedContext {
+				break
+			}
+		}
+
+		from := start - unifiedContext
+		if from < 0 {
+			from = 0
+		}
+		to := end + unifiedContext + 1
+		if to > len(edits) {
+			to = len(edits)
+		}
+
+		// Line numbers of the hunk in both files
+		aLine, bLine := 1, 1
+		for _, e := range edits[:from] {
+			if e.kind != '+' {
+				aLine++
+			}
+			if e.kind != '-' {
+				bLine++
+			}
+		}
+
+		var body strings.Builder
+		aCount, bCount := 0, 0
+		for _, e := range edits[from:to] {
+			body.WriteByte(e.kind)
+			body.WriteString(e.text)
+			body.WriteByte('\n')
+			if e.kind != '+' {
+				aCount++
+			}
+			if e.kind != '-' {
+				bCount++
+			}
+		}
+
+		result = append(result, fmt.Sprintf("@@ -%s +%s @@\n%s", hunkRange(aLine, aCount), hunkRange(bLine, bCount), body.String()))
+		start = to
+	}
+	return result
+}
+
+func hunkRange(line, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", line-1)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", line)
+	}
+	return fmt.Sprintf("%d,%d", line, count)
+}
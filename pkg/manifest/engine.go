@@ -0,0 +1,396 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// LabelWorkflowID is the workflow label holding the experiment ID
+	LabelWorkflowID = "workflow_id"
+
+	// LabelInfraID is the workflow label holding the ID of the chaos infrastructure
+	LabelInfraID = "infra_id"
+
+	// LabelControllerInstanceID selects the Argo controller of the chaos infrastructure
+	LabelControllerInstanceID = "workflows.argoproj.io/controller-instanceid"
+
+	// AnnotationProbeRef is the ChaosEngine annotation referencing the probes of a fault
+	AnnotationProbeRef = "probeRef"
+
+	kindChaosEngine = "ChaosEngine"
+)
+
+// ProbeRef references a probe from a ChaosEngine
+type ProbeRef struct {
+	Name string `json:"name"`
+	Mode string `json:"mode"`
+}
+
+// Fault is a ChaosEngine embedded in a workflow manifest
+type Fault struct {
+	// Name is the generateName of the ChaosEngine, which ChaosCenter uses as the fault name
+	Name string
+
+	// Template is the name of the workflow template running the fault
+	Template     string
+	Namespace    string
+	AppNamespace string
+	ProbeRefs    []ProbeRef
+}
+
+// RetargetOptions describes how Retarget rewrites a workflow manifest. Empty fields are left unchanged.
+type RetargetOptions struct {
+	// Name replaces the workflow name and the workflow_name label of every ChaosEngine
+	Name string
+
+	// ExperimentID replaces the workflow_id label
+	ExperimentID string
+
+	// InfraID replaces the infra_id and controller-instanceid labels
+	InfraID string
+
+	// Namespace replaces the namespace of the workflow
+	Namespace string
+
+	// AppNamespace replaces the application namespace (spec.appinfo.appns) of every ChaosEngine
+	AppNamespace string
+
+	// Parameters sets workflow arguments such as adminModeNamespace, adding those which are missing
+	Parameters map[string]string
+}
+
+// Faults returns the ChaosEngines of a Workflow or CronWorkflow manifest in template order
+func Faults(manifest string) ([]Fault, error) {
+	doc, err := parseObject(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	var faults []Fault
+	err = eachEngine(doc, false, func(template string, engine yaml.MapSlice) (yaml.MapSlice, error) {
+		fault, err := faultOf(template, engine)
+		if err != nil {
+			return nil, err
+		}
+		faults = append(faults, fault)
+		return nil, nil
+	})
+	return faults, err
+}
+
+// faultOf describes the ChaosEngine run by a workflow template
+func faultOf(template string, engine yaml.MapSlice) (Fault, error) {
+	fault := Fault{Template: template}
+	fault.Name, _ = lookup(engine, "metadata", "generateName").(string)
+	if fault.Name == "" {
+		fault.Name, _ = lookup(engine, "metadata", "name").(string)
+	}
+	fault.Namespace, _ = lookup(engine, "metadata", "namespace").(string)
+	fault.AppNamespace, _ = lookup(engine, "spec", "appinfo", "appns").(string)
+
+	if refs, ok := lookup(engine, "metadata", "annotations", AnnotationProbeRef).(string); ok && refs != "" {
+		if err := json.Unmarshal([]byte(refs), &fault.ProbeRefs); err != nil {
+			return fault, fmt.Errorf("invalid %s annotation of fault %s: %v", AnnotationProbeRef, fault.Name, err)
+		}
+	}
+	return fault, nil
+}
+
+// ProbeNames returns the sorted names of all probes referenced by a manifest
+func ProbeNames(manifest string) ([]string, error) {
+	faults, err := Faults(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var names []string
+	for _, fault := range faults {
+		for _, ref := range fault.ProbeRefs {
+			if !seen[ref.Name] {
+				seen[ref.Name] = true
+				names = append(names, ref.Name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// Retarget rewrites a Workflow or CronWorkflow manifest so that it can be saved
+// as another experiment, possibly on another chaos infrastructure. The result is JSON.
+func Retarget(manifest string, options RetargetOptions) (string, error) {
+	doc, err := parseObject(manifest)
+	if err != nil {
+		return "", err
+	}
+
+	kind, _ := doc["kind"].(string)
+	if kind != KindWorkflow && kind != KindCronWorkflow {
+		return "", fmt.Errorf("unsupported manifest kind %q, expected %s or %s", kind, KindWorkflow, KindCronWorkflow)
+	}
+
+	metadata := object(doc, "metadata")
+	if options.Name != "" {
+		metadata["name"] = options.Name
+		delete(metadata, "generateName")
+	}
+	if options.Namespace != "" {
+		metadata["namespace"] = options.Namespace
+	}
+
+	labelSets := []map[string]interface{}{object(metadata, "labels")}
+	if kind == KindCronWorkflow {
+		labelSets = append(labelSets, object(object(object(doc, "spec"), "workflowMetadata"), "labels"))
+	}
+	for _, labels := range labelSets {
+		if options.ExperimentID != "" {
+			labels[LabelWorkflowID] = options.ExperimentID
+		}
+		if options.InfraID != "" {
+			labels[LabelInfraID] = options.InfraID
+			labels[LabelControllerInstanceID] = options.InfraID
+		}
+		// The revision belongs to the source experiment, ChaosCenter assigns a new one on save
+		delete(labels, "revision_id")
+	}
+
+	if len(options.Parameters) > 0 {
+		setParameters(workflowSpec(doc), options.Parameters)
+	}
+
+	if options.Name != "" || options.AppNamespace != "" {
+		err = eachEngine(doc, true, func(_ string, engine yaml.MapSlice) (yaml.MapSlice, error) {
+			if options.Name != "" {
+				engine = update(engine, []string{"metadata", "labels", "workflow_name"}, options.Name)
+			}
+			if options.AppNamespace != "" {
+				engine = update(engine, []string{"spec", "appinfo", "appns"}, options.AppNamespace)
+			}
+			return engine, nil
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	out, err := json.Marshal(doc)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal manifest: %v", err)
+	}
+	return string(out), nil
+}
+
+// workflowSpec returns the spec holding the templates of a Workflow or CronWorkflow
+func workflowSpec(doc map[string]interface{}) map[string]interface{} {
+	spec := object(doc, "spec")
+	if kind, _ := doc["kind"].(string); kind == KindCronWorkflow {
+		return object(spec, "workflowSpec")
+	}
+	return spec
+}
+
+// setParameters sets the values of workflow arguments, appending those which are missing
+func setParameters(spec map[string]interface{}, values map[string]string) {
+	arguments := object(spec, "arguments")
+	parameters, _ := arguments["parameters"].([]interface{})
+
+	set := make(map[string]bool, len(values))
+	for _, p := range parameters {
+		param, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := param["name"].(string)
+		if value, ok := values[name]; ok {
+			param["value"] = value
+			set[name] = true
+		}
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		if !set[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		parameters = append(parameters, map[string]interface{}{"name": name, "value": values[name]})
+	}
+	arguments["parameters"] = parameters
+}
+
+// eachEngine calls fn with every ChaosEngine embedded in the templates of a
+// workflow. When write is set, the engine returned by fn replaces the artifact;
+// a nil engine leaves the artifact untouched.
+// Like ChaosCenter, only the first artifact of a template is considered.
+func eachEngine(doc map[string]interface{}, write bool, fn func(template string, engine yaml.MapSlice) (yaml.MapSlice, error)) error {
+	templates, _ := workflowSpec(doc)["templates"].([]interface{})
+	for _, t := range templates {
+		template, ok := t.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := template["name"].(string)
+
+		inputs, _ := template["inputs"].(map[string]interface{})
+		artifacts, _ := inputs["artifacts"].([]interface{})
+		if len(artifacts) == 0 {
+			continue
+		}
+		artifact, _ := artifacts[0].(map[string]interface{})
+		raw, _ := artifact["raw"].(map[string]interface{})
+		data, _ := raw["data"].(string)
+		if data == "" {
+			continue
+		}
+
+		engine, err := parseEngine(data)
+		if err != nil {
+			if write {
+				return fmt.Errorf("failed to parse artifact of template %s: %v", name, err)
+			}
+			continue
+		}
+		if kind, _ := lookup(engine, "kind").(string); kind != kindChaosEngine {
+			continue
+		}
+
+		updated, err := fn(name, engine)
+		if err != nil {
+			return err
+		}
+		if write && updated != nil {
+			out, err := yaml.Marshal(updated)
+			if err != nil {
+				return fmt.Errorf("failed to marshal artifact of template %s: %v", name, err)
+			}
+			raw["data"] = string(out)
+		}
+	}
+	return nil
+}
+
+// expression matches Argo template expressions such as {{workflow.parameters.appNamespace}}
+var expression = regexp.MustCompile(`\{\{[^{}]*\}\}`)
+
+// parseEngine parses an embedded YAML document. Unquoted Argo expressions would be
+// read as YAML flow mappings, so they are swapped for placeholders while parsing
+// and restored as plain strings, which yaml.v2 quotes as needed when marshalling.
+func parseEngine(data string) (yaml.MapSlice, error) {
+	var expressions []string
+	protected := expression.ReplaceAllStringFunc(data, func(expr string) string {
+		expressions = append(expressions, expr)
+		return fmt.Sprintf("__litmus_expression_%d__", len(expressions)-1)
+	})
+
+	var engine yaml.MapSlice
+	if err := yaml.Unmarshal([]byte(protected), &engine); err != nil {
+		return nil, err
+	}
+	if len(expressions) == 0 {
+		return engine, nil
+	}
+
+	restore := strings.NewReplacer(func() []string {
+		pairs := make([]string, 0, 2*len(expressions))
+		for i, expr := range expressions {
+			pairs = append(pairs, fmt.Sprintf("__litmus_expression_%d__", i), expr)
+		}
+		return pairs
+	}()...)
+	return restoreExpressions(engine, restore).(yaml.MapSlice), nil
+}
+
+// restoreExpressions replaces the placeholders in every string of a parsed document
+func restoreExpressions(v interface{}, restore *strings.Replacer) interface{} {
+	switch t := v.(type) {
+	case string:
+		return restore.Replace(t)
+	case yaml.MapSlice:
+		for i := range t {
+			t[i].Key = restoreExpressions(t[i].Key, restore)
+			t[i].Value = restoreExpressions(t[i].Value, restore)
+		}
+		return t
+	case []interface{}:
+		for i := range t {
+			t[i] = restoreExpressions(t[i], restore)
+		}
+		return t
+	default:
+		return v
+	}
+}
+
+// object returns the object stored under key, creating it if missing
+func object(parent map[string]interface{}, key string) map[string]interface{} {
+	child, ok := parent[key].(map[string]interface{})
+	if !ok {
+		child = make(map[string]interface{})
+		parent[key] = child
+	}
+	return child
+}
+
+// lookup returns the value at a path of keys in an ordered YAML document
+func lookup(doc yaml.MapSlice, path ...string) interface{} {
+	var current interface{} = doc
+	for _, key := range path {
+		m, ok := current.(yaml.MapSlice)
+		if !ok {
+			return nil
+		}
+		current = nil
+		for _, item := range m {
+			if fmt.Sprint(item.Key) == key {
+				current = item.Value
+				break
+			}
+		}
+	}
+	return current
+}
+
+// update sets the value at a path of keys in an ordered YAML document, creating missing objects
+func update(doc yaml.MapSlice, path []string, value interface{}) yaml.MapSlice {
+	for i, item := range doc {
+		if fmt.Sprint(item.Key) != path[0] {
+			continue
+		}
+		if len(path) == 1 {
+			doc[i].Value = value
+		} else {
+			child, _ := item.Value.(yaml.MapSlice)
+			doc[i].Value = update(child, path[1:], value)
+		}
+		return doc
+	}
+
+	if len(path) == 1 {
+		return append(doc, yaml.MapItem{Key: path[0], Value: value})
+	}
+	return append(doc, yaml.MapItem{Key: path[0], Value: update(nil, path[1:], value)})
+}
@@ -0,0 +1,148 @@
+package manifest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+const engineManifest = `{
+	"apiVersion": "argoproj.io/v1alpha1",
+	"kind": "Workflow",
+	"metadata": {
+		"name": "checkout-chaos",
+		"namespace": "litmus",
+		"labels": {
+			"workflow_id": "exp-1",
+			"infra_id": "infra-staging",
+			"workflows.argoproj.io/controller-instanceid": "infra-staging",
+			"revision_id": "rev-1"
+		}
+	},
+	"spec": {
+		"arguments": {"parameters": [{"name": "adminModeNamespace", "value": "litmus"}]},
+		"templates": [
+			{"name": "argowf-chaos", "steps": [[{"name": "pod-delete", "template": "pod-delete"}]]},
+			{
+				"name": "pod-delete",
+				"inputs": {"artifacts": [{"name": "pod-delete", "raw": {"data": "apiVersion: litmuschaos.io/v1alpha1\nkind: ChaosEngine\nmetadata:\n  namespace: \"{{workflow.parameters.adminModeNamespace}}\"\n  labels:\n    workflow_name: checkout-chaos\n  annotations:\n    probeRef: '[{\"name\":\"checkout-http\",\"mode\":\"SOT\"},{\"name\":\"latency\",\"mode\":\"Continuous\"}]'\n  generateName: pod-delete\nspec:\n  appinfo:\n    appns: staging\n    applabel: app=checkout\n"}}]}
+			},
+			{
+				"name": "network-loss",
+				"inputs": {"artifacts": [{"name": "network-loss", "raw": {"data": "kind: ChaosEngine\nmetadata:\n  namespace: {{workflow.parameters.adminModeNamespace}}\n  generateName: network-loss\n  annotations:\n    probeRef: '[{\"name\":\"checkout-http\",\"mode\":\"Edge\"}]'\n"}}]}
+			},
+			{
+				"name": "install",
+				"inputs": {"artifacts": [{"name": "install", "raw": {"data": "kind: ChaosExperiment\nmetadata:\n  name: pod-delete\n"}}]}
+			}
+		]
+	}
+}`
+
+func TestFaults(t *testing.T) {
+	faults, err := Faults(engineManifest)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []Fault{
+		{
+			Name:         "pod-delete",
+			Template:     "pod-delete",
+			Namespace:    "{{workflow.parameters.adminModeNamespace}}",
+			AppNamespace: "staging",
+			ProbeRefs:    []ProbeRef{{Name: "checkout-http", Mode: "SOT"}, {Name: "latency", Mode: "Continuous"}},
+		},
+		{
+			Name:      "network-loss",
+			Template:  "network-loss",
+			Namespace: "{{workflow.parameters.adminModeNamespace}}",
+			ProbeRefs: []ProbeRef{{Name: "checkout-http", Mode: "Edge"}},
+		},
+	}, faults)
+
+	names, err := ProbeNames(engineManifest)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"checkout-http", "latency"}, names)
+}
+
+func TestRetarget(t *testing.T) {
+	out, err := Retarget(engineManifest, RetargetOptions{
+		Name:         "checkout-chaos-prod",
+		ExperimentID: "exp-2",
+		InfraID:      "infra-prod",
+		Namespace:    "litmus-prod",
+		AppNamespace: "prod",
+		Parameters:   map[string]string{"adminModeNamespace": "litmus-prod", "appNamespace": "prod"},
+	})
+	assert.NoError(t, err)
+
+	var result struct {
+		Metadata struct {
+			Name      string            `json:"name"`
+			Namespace string            `json:"namespace"`
+			Labels    map[string]string `json:"labels"`
+		} `json:"metadata"`
+		Spec struct {
+			Arguments struct {
+				Parameters []map[string]string `json:"parameters"`
+			} `json:"arguments"`
+			Templates []struct {
+				Inputs struct {
+					Artifacts []struct {
+						Raw struct {
+							Data string `json:"data"`
+						} `json:"raw"`
+					} `json:"artifacts"`
+				} `json:"inputs"`
+			} `json:"templates"`
+		} `json:"spec"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(out), &result))
+
+	assert.Equal(t, "checkout-chaos-prod", result.Metadata.Name)
+	assert.Equal(t, "litmus-prod", result.Metadata.Namespace)
+	assert.Equal(t, map[string]string{
+		LabelWorkflowID:           "exp-2",
+		LabelInfraID:              "infra-prod",
+		LabelControllerInstanceID: "infra-prod",
+	}, result.Metadata.Labels)
+	assert.Equal(t, []map[string]string{
+		{"name": "adminModeNamespace", "value": "litmus-prod"},
+		{"name": "appNamespace", "value": "prod"},
+	}, result.Spec.Arguments.Parameters)
+
+	var engine yaml.MapSlice
+	assert.NoError(t, yaml.Unmarshal([]byte(result.Spec.Templates[1].Inputs.Artifacts[0].Raw.Data), &engine))
+	assert.Equal(t, "checkout-chaos-prod", lookup(engine, "metadata", "labels", "workflow_name"))
+	assert.Equal(t, "prod", lookup(engine, "spec", "appinfo", "appns"))
+	assert.Equal(t, "app=checkout", lookup(engine, "spec", "appinfo", "applabel"))
+	assert.Equal(t, "apiVersion", engine[0].Key, "key order of the engine must be kept")
+	assert.Contains(t, result.Spec.Templates[2].Inputs.Artifacts[0].Raw.Data, "namespace: '{{workflow.parameters.adminModeNamespace}}'")
+
+	faults, err := Faults(out)
+	assert.NoError(t, err)
+	assert.Len(t, faults[0].ProbeRefs, 2)
+}
+
+func TestRetargetCronWorkflow(t *testing.T) {
+	cron, err := ToCronWorkflow(engineManifest, "0 * * * *", "")
+	assert.NoError(t, err)
+
+	out, err := Retarget(cron, RetargetOptions{InfraID: "infra-prod"})
+	assert.NoError(t, err)
+
+	var result struct {
+		Spec struct {
+			WorkflowMetadata struct {
+				Labels map[string]string `json:"labels"`
+			} `json:"workflowMetadata"`
+		} `json:"spec"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(out), &result))
+	assert.Equal(t, "infra-prod", result.Spec.WorkflowMetadata.Labels[LabelInfraID])
+
+	names, err := ProbeNames(out)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"checkout-http", "latency"}, names)
+}
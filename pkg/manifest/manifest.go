@@ -0,0 +1,132 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package manifest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Parse decodes an experiment manifest into a generic document.
+// ChaosCenter stores manifests as JSON, but YAML is accepted as well so that
+// local files can be compared against the server copy.
+func Parse(manifest string) (interface{}, error) {
+	if strings.TrimSpace(manifest) == "" {
+		return nil, fmt.Errorf("manifest cannot be empty")
+	}
+
+	var doc interface{}
+	if err := json.Unmarshal([]byte(manifest), &doc); err == nil {
+		return doc, nil
+	}
+
+	var yamlDoc interface{}
+	if err := yaml.Unmarshal([]byte(manifest), &yamlDoc); err != nil {
+		return nil, fmt.Errorf("manifest is neither valid JSON nor YAML: %v", err)
+	}
+
+	return normalize(yamlDoc)
+}
+
+// UnmarshalStrict decodes a YAML or JSON document into v, rejecting unknown
+// fields. The document goes through JSON so that the json tags of v apply,
+// which lets definition files use the field names of the API types.
+func UnmarshalStrict(data []byte, v interface{}) error {
+	doc, err := Parse(string(data))
+	if err != nil {
+		return err
+	}
+
+	raw, err := json.Marshal(doc)
+	if err != nil {
+		return fmt.Errorf("failed to marshal document: %v", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(v)
+}
+
+// Fingerprint returns a short content hash of the manifest which is stable
+// across formatting and key ordering differences.
+func Fingerprint(manifest string) (string, error) {
+	doc, err := Parse(manifest)
+	if err != nil {
+		return "", err
+	}
+
+	canonical, err := json.Marshal(doc)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal manifest: %v", err)
+	}
+
+	sum := sha256.Sum256(canonical)
+	return hex.EncodeToString(sum[:])[:12], nil
+}
+
+// normalize converts the map[interface{}]interface{} values produced by yaml.v2
+// into the map[string]interface{} shape produced by encoding/json, and numbers
+// into float64, so that documents from both sources compare equal.
+func normalize(v interface{}) (interface{}, error) {
+	switch t := v.(type) {
+	case map[interface{}]interface{}:
+		out := make(map[string]interface{}, len(t))
+		for k, val := range t {
+			n, err := normalize(val)
+			if err != nil {
+				return nil, err
+			}
+			out[fmt.Sprintf("%v", k)] = n
+		}
+		return out, nil
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(t))
+		for k, val := range t {
+			n, err := normalize(val)
+			if err != nil {
+				return nil, err
+			}
+			out[k] = n
+		}
+		return out, nil
+	case []interface{}:
+		out := make([]interface{}, len(t))
+		for i, val := range t {
+			n, err := normalize(val)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = n
+		}
+		return out, nil
+	case int:
+		return float64(t), nil
+	case int64:
+		return float64(t), nil
+	case uint64:
+		return float64(t), nil
+	case float32:
+		return float64(t), nil
+	default:
+		return t, nil
+	}
+}
@@ -0,0 +1,185 @@
+package manifest
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const baseManifest = `{
+	"apiVersion": "argoproj.io/v1alpha1",
+	"kind": "Workflow",
+	"metadata": {"name": "test-experiment", "namespace": "litmus"},
+	"spec": {
+		"arguments": {"parameters": [{"name": "appNamespace", "value": "default"}]},
+		"templates": [{"name": "run-chaos", "inputs": {"artifacts": [{"raw": {"data": "kind: ChaosEngine\nspec:\n  duration: \"30\"\n"}}]}}]
+	}
+}`
+
+func TestFingerprint(t *testing.T) {
+	yamlManifest := `
+kind: Workflow
+apiVersion: argoproj.io/v1alpha1
+metadata:
+  namespace: litmus
+  name: test-experiment
+spec:
+  arguments:
+    parameters:
+    - name: appNamespace
+      value: default
+  templates:
+  - name: run-chaos
+    inputs:
+      artifacts:
+      - raw:
+          data: |
+            kind: ChaosEngine
+            spec:
+              duration: "30"
+`
+
+	tests := []struct {
+		name      string
+		a         string
+		b         string
+		wantEqual bool
+		wantErr   bool
+	}{
+		{
+			name:      "same manifest in JSON and YAML",
+			a:         baseManifest,
+			b:         yamlManifest,
+			wantEqual: true,
+		},
+		{
+			name:      "different manifests",
+			a:         baseManifest,
+			b:         strings.Replace(baseManifest, "test-experiment", "other", 1),
+			wantEqual: false,
+		},
+		{
+			name:    "empty manifest",
+			a:       baseManifest,
+			b:       "",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := Fingerprint(tt.a)
+			assert.NoError(t, err)
+
+			b, err := Fingerprint(tt.b)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, a, 12)
+			assert.Equal(t, tt.wantEqual, a == b)
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name        string
+		from        string
+		to          string
+		wantOps     []Operation
+		wantUnified []string
+	}{
+		{
+			name:    "identical manifests",
+			from:    baseManifest,
+			to:      baseManifest,
+			wantOps: nil,
+		},
+		{
+			name: "changed scalar and embedded chaos engine",
+			from: baseManifest,
+			to: strings.NewReplacer(
+				`"value": "default"`, `"value": "prod"`,
+				`duration: \"30\"`, `duration: \"60\"`,
+			).Replace(baseManifest),
+			wantOps: []Operation{
+				{Op: "replace", Path: "/spec/arguments/parameters/0/value", Value: "prod"},
+				{Op: "replace", Path: "/spec/templates/0/inputs/artifacts/0/raw/data", Value: "kind: ChaosEngine\nspec:\n  duration: \"60\"\n"},
+			},
+			wantUnified: []string{"-      value: default", "+      value: prod", `-              duration: "30"`, `+              duration: "60"`},
+		},
+		{
+			name: "added and removed keys",
+			from: `{"metadata": {"name": "a", "labels": {"x/y": "1"}}}`,
+			to:   `{"metadata": {"name": "a", "annotations": {"k": "v"}}}`,
+			wantOps: []Operation{
+				{Op: "add", Path: "/metadata/annotations", Value: map[string]interface{}{"k": "v"}},
+				{Op: "remove", Path: "/metadata/labels"},
+			},
+		},
+		{
+			name: "shrinking array removes from the end",
+			from: `{"items": [1, 2, 3]}`,
+			to:   `{"items": [1]}`,
+			wantOps: []Operation{
+				{Op: "remove", Path: "/items/2"},
+				{Op: "remove", Path: "/items/1"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff, err := Compare(tt.from, tt.to)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOps, diff.Operations)
+			assert.Equal(t, len(tt.wantOps) == 0, diff.Empty())
+
+			patch, err := diff.JSONPatch()
+			assert.NoError(t, err)
+			var decoded []map[string]interface{}
+			assert.NoError(t, json.Unmarshal(patch, &decoded))
+			assert.Len(t, decoded, len(tt.wantOps))
+
+			unified := diff.Unified("server", "local")
+			if diff.Empty() {
+				assert.Empty(t, unified)
+				return
+			}
+			assert.True(t, strings.HasPrefix(unified, "--- server\n+++ local\n"))
+			assert.Contains(t, unified, "@@ -")
+			for _, line := range tt.wantUnified {
+				assert.Contains(t, unified, line+"\n")
+			}
+		})
+	}
+}
+
+func TestEscapePointer(t *testing.T) {
+	assert.Equal(t, "a~1b~0c", escapePointer("a/b~c"))
+}
+
+func TestUnmarshalStrict(t *testing.T) {
+	type spec struct {
+		APIVersion string `json:"apiVersion"`
+		Items      []struct {
+			Name  string `json:"name"`
+			Count int    `json:"count"`
+		} `json:"items"`
+	}
+
+	var fromYAML, fromJSON spec
+	assert.NoError(t, UnmarshalStrict([]byte("apiVersion: v1\nitems:\n  - name: a\n    count: 2\n"), &fromYAML))
+	assert.NoError(t, UnmarshalStrict([]byte(`{"apiVersion": "v1", "items": [{"name": "a", "count": 2}]}`), &fromJSON))
+	assert.Equal(t, fromJSON, fromYAML)
+	assert.Equal(t, "a", fromYAML.Items[0].Name)
+	assert.Equal(t, 2, fromYAML.Items[0].Count)
+
+	var s spec
+	assert.ErrorContains(t, UnmarshalStrict([]byte("apiVersion: v1\nitems:\n  - name: a\n    size: 2\n"), &s), `unknown field "size"`)
+	assert.Error(t, UnmarshalStrict([]byte("apiVersion: [v1"), &s))
+}
@@ -0,0 +1,227 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"gopkg.in/yaml.v2"
+)
+
+// ProbeAttachment attaches a probe to a fault of a workflow manifest
+type ProbeAttachment struct {
+	// Fault is the fault name, i.e. the generateName of the ChaosEngine, or the name of its template
+	Fault string
+
+	// Probe is the name of the probe in ChaosCenter
+	Probe string
+
+	Mode models.Mode
+
+	// Spec is the definition of the probe in the given mode, as returned by
+	// GetProbeYAML. When set, it is added to the probe list of the ChaosEngine
+	// experiments along with the probeRef annotation.
+	Spec string
+}
+
+// AttachProbes references probes from faults of a Workflow or CronWorkflow
+// manifest. A probe which is already attached to the fault is replaced, so
+// attaching it again changes its mode. The result is JSON.
+func AttachProbes(manifest string, attachments ...ProbeAttachment) (string, error) {
+	specs := make([]interface{}, len(attachments))
+	for i, a := range attachments {
+		if a.Fault == "" || a.Probe == "" {
+			return "", fmt.Errorf("fault and probe names are required")
+		}
+		if !a.Mode.IsValid() {
+			return "", fmt.Errorf("invalid mode %q of probe %s, expected one of %v", a.Mode, a.Probe, models.AllMode)
+		}
+		if a.Spec == "" {
+			continue
+		}
+
+		var spec yaml.MapSlice
+		if err := yaml.Unmarshal([]byte(a.Spec), &spec); err != nil {
+			return "", fmt.Errorf("invalid definition of probe %s: %v", a.Probe, err)
+		}
+		if name, _ := lookup(spec, "name").(string); name != a.Probe {
+			return "", fmt.Errorf("definition of probe %s is named %q", a.Probe, name)
+		}
+		specs[i] = spec
+	}
+
+	attached := make([]bool, len(attachments))
+	out, err := editEngines(manifest, func(fault Fault, engine yaml.MapSlice) (yaml.MapSlice, error) {
+		targeted := false
+		for i, a := range attachments {
+			if a.Fault != fault.Name && a.Fault != fault.Template {
+				continue
+			}
+			attached[i] = true
+			targeted = true
+
+			refs := withoutProbe(fault.ProbeRefs, a.Probe)
+			fault.ProbeRefs = append(refs, ProbeRef{Name: a.Probe, Mode: string(a.Mode)})
+			if specs[i] != nil {
+				engine = setProbeSpec(engine, a.Probe, specs[i])
+			}
+		}
+		if !targeted {
+			return nil, nil
+		}
+		return setProbeRefs(engine, fault.ProbeRefs)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	for i, a := range attachments {
+		if !attached[i] {
+			return "", fmt.Errorf("fault %s not found", a.Fault)
+		}
+	}
+	return out, nil
+}
+
+// DetachProbes removes the references to probes from a fault of a Workflow or
+// CronWorkflow manifest, along with their definitions in the ChaosEngine. The
+// result is JSON.
+func DetachProbes(manifest string, fault string, probes ...string) (string, error) {
+	found := false
+	out, err := editEngines(manifest, func(f Fault, engine yaml.MapSlice) (yaml.MapSlice, error) {
+		if fault != f.Name && fault != f.Template {
+			return nil, nil
+		}
+		found = true
+
+		for _, name := range probes {
+			refs := withoutProbe(f.ProbeRefs, name)
+			if len(refs) == len(f.ProbeRefs) {
+				return nil, fmt.Errorf("probe %s is not attached to fault %s", name, f.Name)
+			}
+			f.ProbeRefs = refs
+			engine = setProbeSpec(engine, name, nil)
+		}
+		return setProbeRefs(engine, f.ProbeRefs)
+	})
+	if err != nil {
+		return "", err
+	}
+	if !found {
+		return "", fmt.Errorf("fault %s not found", fault)
+	}
+	return out, nil
+}
+
+// editEngines rewrites the ChaosEngines of a workflow manifest and returns the
+// manifest as JSON. Engines for which fn returns nil are left as they are.
+func editEngines(manifest string, fn func(fault Fault, engine yaml.MapSlice) (yaml.MapSlice, error)) (string, error) {
+	doc, err := parseObject(manifest)
+	if err != nil {
+		return "", err
+	}
+	if kind, _ := doc["kind"].(string); kind != KindWorkflow && kind != KindCronWorkflow {
+		return "", fmt.Errorf("unsupported manifest kind %q, expected %s or %s", kind, KindWorkflow, KindCronWorkflow)
+	}
+
+	err = eachEngine(doc, true, func(template string, engine yaml.MapSlice) (yaml.MapSlice, error) {
+		fault, err := faultOf(template, engine)
+		if err != nil {
+			return nil, err
+		}
+		return fn(fault, engine)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	out, err := json.Marshal(doc)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal manifest: %v", err)
+	}
+	return string(out), nil
+}
+
+func withoutProbe(refs []ProbeRef, name string) []ProbeRef {
+	kept := make([]ProbeRef, 0, len(refs))
+	for _, ref := range refs {
+		if ref.Name != name {
+			kept = append(kept, ref)
+		}
+	}
+	return kept
+}
+
+// setProbeRefs writes the probeRef annotation, removing it when no probe is left
+func setProbeRefs(engine yaml.MapSlice, refs []ProbeRef) (yaml.MapSlice, error) {
+	if len(refs) == 0 {
+		annotations, ok := lookup(engine, "metadata", "annotations").(yaml.MapSlice)
+		if !ok {
+			return engine, nil
+		}
+		return update(engine, []string{"metadata", "annotations"}, removeKey(annotations, AnnotationProbeRef)), nil
+	}
+
+	data, err := json.Marshal(refs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s annotation: %v", AnnotationProbeRef, err)
+	}
+	return update(engine, []string{"metadata", "annotations", AnnotationProbeRef}, string(data)), nil
+}
+
+// setProbeSpec replaces the definition of a probe in the probe list of every
+// experiment of a ChaosEngine, appending it when missing. A nil spec removes it.
+func setProbeSpec(engine yaml.MapSlice, name string, spec interface{}) yaml.MapSlice {
+	experiments, _ := lookup(engine, "spec", "experiments").([]interface{})
+	for i, e := range experiments {
+		experiment, ok := e.(yaml.MapSlice)
+		if !ok {
+			continue
+		}
+
+		current, _ := lookup(experiment, "spec", "probe").([]interface{})
+		probes := make([]interface{}, 0, len(current)+1)
+		for _, p := range current {
+			if m, ok := p.(yaml.MapSlice); ok && fmt.Sprint(lookup(m, "name")) == name {
+				continue
+			}
+			probes = append(probes, p)
+		}
+		if spec != nil {
+			probes = append(probes, spec)
+		}
+
+		if len(probes) == 0 {
+			experimentSpec, _ := lookup(experiment, "spec").(yaml.MapSlice)
+			experiments[i] = update(experiment, []string{"spec"}, removeKey(experimentSpec, "probe"))
+		} else {
+			experiments[i] = update(experiment, []string{"spec", "probe"}, probes)
+		}
+	}
+	return engine
+}
+
+func removeKey(doc yaml.MapSlice, key string) yaml.MapSlice {
+	kept := make(yaml.MapSlice, 0, len(doc))
+	for _, item := range doc {
+		if fmt.Sprint(item.Key) != key {
+			kept = append(kept, item)
+		}
+	}
+	return kept
+}
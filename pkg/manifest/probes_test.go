@@ -0,0 +1,130 @@
+package manifest
+
+import (
+	"encoding/json"
+	"testing"
+
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+const httpProbeSpec = `{"name":"checkout-http","type":"httpProbe","httpProbe/inputs":{"url":"http://checkout","method":{"get":{"criteria":"==","responseCode":"200"}}},"runProperties":{"probeTimeout":"5s","interval":"2s","attempt":1},"mode":"EOT"}`
+
+// engineProbes returns the probe definitions of the first experiment of the ChaosEngine of a template
+func engineProbes(t *testing.T, manifest string, template string) []interface{} {
+	doc, err := parseObject(manifest)
+	require.NoError(t, err)
+
+	var probes []interface{}
+	require.NoError(t, eachEngine(doc, false, func(name string, engine yaml.MapSlice) (yaml.MapSlice, error) {
+		if name == template {
+			experiments, _ := lookup(engine, "spec", "experiments").([]interface{})
+			require.NotEmpty(t, experiments)
+			probes, _ = lookup(experiments[0].(yaml.MapSlice), "spec", "probe").([]interface{})
+		}
+		return nil, nil
+	}))
+	return probes
+}
+
+func TestAttachProbes(t *testing.T) {
+	doc, err := parseObject(engineManifest)
+	require.NoError(t, err)
+	require.NoError(t, eachEngine(doc, true, func(_ string, engine yaml.MapSlice) (yaml.MapSlice, error) {
+		return update(engine, []string{"spec", "experiments"}, []interface{}{yaml.MapSlice{{Key: "name", Value: "pod-delete"}}}), nil
+	}))
+	withExperiments, err := json.Marshal(doc)
+	require.NoError(t, err)
+
+	// Attaching an attached probe changes its mode
+	out, err := AttachProbes(string(withExperiments),
+		ProbeAttachment{Fault: "pod-delete", Probe: "checkout-http", Mode: models.ModeEot, Spec: httpProbeSpec},
+		ProbeAttachment{Fault: "network-loss", Probe: "latency", Mode: models.ModeOnChaos},
+	)
+	require.NoError(t, err)
+
+	faults, err := Faults(out)
+	require.NoError(t, err)
+	assert.Equal(t, []ProbeRef{{Name: "latency", Mode: "Continuous"}, {Name: "checkout-http", Mode: "EOT"}}, faults[0].ProbeRefs)
+	assert.Equal(t, []ProbeRef{{Name: "checkout-http", Mode: "Edge"}, {Name: "latency", Mode: "OnChaos"}}, faults[1].ProbeRefs)
+
+	probes := engineProbes(t, out, "pod-delete")
+	require.Len(t, probes, 1)
+	assert.Equal(t, "checkout-http", lookup(probes[0].(yaml.MapSlice), "name"))
+	assert.Equal(t, "EOT", lookup(probes[0].(yaml.MapSlice), "mode"))
+	assert.Equal(t, "http://checkout", lookup(probes[0].(yaml.MapSlice), "httpProbe/inputs", "url"))
+
+	// Detaching removes the reference and the definition
+	out, err = DetachProbes(out, "pod-delete", "checkout-http", "latency")
+	require.NoError(t, err)
+	faults, err = Faults(out)
+	require.NoError(t, err)
+	assert.Empty(t, faults[0].ProbeRefs)
+	assert.Empty(t, engineProbes(t, out, "pod-delete"))
+	assert.Len(t, faults[1].ProbeRefs, 2)
+}
+
+// artifacts returns the raw artifact data of every template of a workflow manifest
+func artifacts(t *testing.T, manifest string) map[string]string {
+	doc, err := parseObject(manifest)
+	require.NoError(t, err)
+
+	data := map[string]string{}
+	templates, _ := workflowSpec(doc)["templates"].([]interface{})
+	for _, tmpl := range templates {
+		template := tmpl.(map[string]interface{})
+		inputs, _ := template["inputs"].(map[string]interface{})
+		if artifacts, _ := inputs["artifacts"].([]interface{}); len(artifacts) > 0 {
+			raw := artifacts[0].(map[string]interface{})["raw"].(map[string]interface{})
+			data[template["name"].(string)] = raw["data"].(string)
+		}
+	}
+	return data
+}
+
+func TestAttachProbesLeavesOtherFaults(t *testing.T) {
+	doc, err := parseObject(engineManifest)
+	require.NoError(t, err)
+	templates := workflowSpec(doc)["templates"].([]interface{})
+	workflowSpec(doc)["templates"] = append(templates, map[string]interface{}{
+		"name": "disk-fill",
+		"inputs": map[string]interface{}{"artifacts": []interface{}{map[string]interface{}{
+			"name": "disk-fill",
+			"raw":  map[string]interface{}{"data": "kind: ChaosEngine\nmetadata:\n  generateName: disk-fill\nspec:\n  engineState: 'active'\n"},
+		}}},
+	})
+	manifest, err := json.Marshal(doc)
+	require.NoError(t, err)
+	before := artifacts(t, string(manifest))
+
+	out, err := AttachProbes(string(manifest), ProbeAttachment{Fault: "pod-delete", Probe: "checkout-http", Mode: models.ModeEot})
+	require.NoError(t, err)
+	after := artifacts(t, out)
+	assert.NotEqual(t, before["pod-delete"], after["pod-delete"])
+	for _, template := range []string{"network-loss", "disk-fill", "install"} {
+		assert.Equal(t, before[template], after[template], "template %s was changed", template)
+	}
+
+	out, err = DetachProbes(string(manifest), "network-loss", "checkout-http")
+	require.NoError(t, err)
+	after = artifacts(t, out)
+	for _, template := range []string{"pod-delete", "disk-fill", "install"} {
+		assert.Equal(t, before[template], after[template], "template %s was changed", template)
+	}
+}
+
+func TestAttachProbesErrors(t *testing.T) {
+	_, err := AttachProbes(engineManifest, ProbeAttachment{Fault: "pod-delete", Probe: "p", Mode: "Always"})
+	assert.ErrorContains(t, err, `invalid mode "Always" of probe p`)
+
+	_, err = AttachProbes(engineManifest, ProbeAttachment{Fault: "disk-fill", Probe: "p", Mode: models.ModeSot})
+	assert.EqualError(t, err, "fault disk-fill not found")
+
+	_, err = AttachProbes(engineManifest, ProbeAttachment{Fault: "pod-delete", Probe: "p", Mode: models.ModeSot, Spec: httpProbeSpec})
+	assert.EqualError(t, err, `definition of probe p is named "checkout-http"`)
+
+	_, err = DetachProbes(engineManifest, "network-loss", "latency")
+	assert.EqualError(t, err, "probe latency is not attached to fault network-loss")
+}
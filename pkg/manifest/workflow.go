@@ -0,0 +1,135 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/cron"
+)
+
+const (
+	// KindWorkflow is the kind of a one-off Argo workflow manifest
+	KindWorkflow = "Workflow"
+
+	// KindCronWorkflow is the kind of a scheduled Argo workflow manifest
+	KindCronWorkflow = "CronWorkflow"
+)
+
+// CronSettings are the scheduling fields of a CronWorkflow manifest
+type CronSettings struct {
+	Schedule  string
+	Timezone  string
+	Suspended bool
+}
+
+// Kind returns the kind of a workflow manifest
+func Kind(manifest string) (string, error) {
+	doc, err := parseObject(manifest)
+	if err != nil {
+		return "", err
+	}
+	kind, _ := doc["kind"].(string)
+	return kind, nil
+}
+
+// ToCronWorkflow converts a Workflow manifest into a CronWorkflow manifest which
+// runs on the given schedule. If the manifest already is a CronWorkflow, only its
+// schedule and timezone are replaced. An empty timezone keeps the default (UTC).
+// The result is JSON, the format ChaosCenter stores manifests in.
+func ToCronWorkflow(manifest string, schedule string, timezone string) (string, error) {
+	if err := cron.Validate(schedule); err != nil {
+		return "", err
+	}
+	if timezone != "" {
+		if _, err := time.LoadLocation(timezone); err != nil {
+			return "", fmt.Errorf("invalid timezone %q: %v", timezone, err)
+		}
+	}
+
+	doc, err := parseObject(manifest)
+	if err != nil {
+		return "", err
+	}
+
+	switch kind, _ := doc["kind"].(string); kind {
+	case KindWorkflow:
+		workflowSpec, _ := doc["spec"].(map[string]interface{})
+		if workflowSpec == nil {
+			return "", fmt.Errorf("workflow manifest has no spec")
+		}
+		doc["kind"] = KindCronWorkflow
+		doc["spec"] = map[string]interface{}{
+			"concurrencyPolicy":       "Forbid",
+			"startingDeadlineSeconds": 0,
+			"workflowSpec":            workflowSpec,
+		}
+	case KindCronWorkflow:
+		if _, ok := doc["spec"].(map[string]interface{}); !ok {
+			return "", fmt.Errorf("cron workflow manifest has no spec")
+		}
+	default:
+		return "", fmt.Errorf("unsupported manifest kind %q, expected %s or %s", kind, KindWorkflow, KindCronWorkflow)
+	}
+
+	spec := doc["spec"].(map[string]interface{})
+	spec["schedule"] = schedule
+	if timezone != "" {
+		spec["timezone"] = timezone
+	} else {
+		delete(spec, "timezone")
+	}
+
+	out, err := json.Marshal(doc)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal manifest: %v", err)
+	}
+	return string(out), nil
+}
+
+// CronSettingsOf returns the scheduling fields of a CronWorkflow manifest
+func CronSettingsOf(manifest string) (CronSettings, error) {
+	doc, err := parseObject(manifest)
+	if err != nil {
+		return CronSettings{}, err
+	}
+
+	if kind, _ := doc["kind"].(string); kind != KindCronWorkflow {
+		return CronSettings{}, fmt.Errorf("manifest kind %q is not %s", kind, KindCronWorkflow)
+	}
+
+	spec, _ := doc["spec"].(map[string]interface{})
+	settings := CronSettings{}
+	settings.Schedule, _ = spec["schedule"].(string)
+	settings.Timezone, _ = spec["timezone"].(string)
+	settings.Suspended, _ = spec["suspend"].(bool)
+	return settings, nil
+}
+
+// parseObject parses a manifest which must be an object
+func parseObject(manifest string) (map[string]interface{}, error) {
+	doc, err := Parse(manifest)
+	if err != nil {
+		return nil, err
+	}
+	obj, ok := doc.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("manifest must be an object")
+	}
+	return obj, nil
+}
@@ -0,0 +1,87 @@
+package manifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToCronWorkflow(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest string
+		schedule string
+		timezone string
+		want     CronSettings
+		wantErr  string
+	}{
+		{
+			name:     "workflow is wrapped into a cron workflow",
+			manifest: baseManifest,
+			schedule: "0 */6 * * *",
+			want:     CronSettings{Schedule: "0 */6 * * *"},
+		},
+		{
+			name:     "cron workflow schedule is replaced",
+			manifest: `{"kind": "CronWorkflow", "spec": {"schedule": "@daily", "timezone": "UTC", "suspend": true, "workflowSpec": {}}}`,
+			schedule: "@hourly",
+			timezone: "Europe/Berlin",
+			want:     CronSettings{Schedule: "@hourly", Timezone: "Europe/Berlin", Suspended: true},
+		},
+		{
+			name:     "invalid schedule",
+			manifest: baseManifest,
+			schedule: "every day",
+			wantErr:  "must have 5 fields",
+		},
+		{
+			name:     "invalid timezone",
+			manifest: baseManifest,
+			schedule: "@daily",
+			timezone: "Mars/Olympus",
+			wantErr:  "invalid timezone",
+		},
+		{
+			name:     "workflow without spec",
+			manifest: `{"kind": "Workflow", "metadata": {"name": "x"}}`,
+			schedule: "@daily",
+			wantErr:  "workflow manifest has no spec",
+		},
+		{
+			name:     "cron workflow without spec",
+			manifest: `{"kind": "CronWorkflow", "metadata": {"name": "x"}}`,
+			schedule: "@daily",
+			wantErr:  "cron workflow manifest has no spec",
+		},
+		{
+			name:     "unsupported kind",
+			manifest: `{"kind": "ChaosEngine"}`,
+			schedule: "@daily",
+			wantErr:  "unsupported manifest kind",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cronManifest, err := ToCronWorkflow(tt.manifest, tt.schedule, tt.timezone)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+
+			kind, err := Kind(cronManifest)
+			assert.NoError(t, err)
+			assert.Equal(t, KindCronWorkflow, kind)
+
+			settings, err := CronSettingsOf(cronManifest)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, settings)
+		})
+	}
+}
+
+func TestCronSettingsOfWorkflow(t *testing.T) {
+	_, err := CronSettingsOf(baseManifest)
+	assert.ErrorContains(t, err, "is not CronWorkflow")
+}
@@ -0,0 +1,384 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package notify posts a summary of finished experiment runs to webhooks, in
+// a generic JSON format or as Slack and Microsoft Teams compatible messages.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/experiment"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/sdk"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+const (
+	defaultRetries = 3
+	defaultBackoff = time.Second
+	defaultTimeout = 10 * time.Second
+
+	// DefaultMessage is the template used when a webhook has none
+	DefaultMessage = `Chaos experiment {{.ExperimentName}} finished: {{.Phase}}` +
+		`{{if .ResiliencyScore}}, resiliency score {{printf "%.2f" (deref .ResiliencyScore)}}{{end}}` +
+		`{{if .FailedProbes}}. Failed probes: {{join .FailedProbes ", "}}{{end}}` +
+		`{{if .Link}}. {{.Link}}{{end}}`
+
+	// DefaultLink is the ChaosCenter UI path of an experiment run, relative to Options.UIBaseURL
+	DefaultLink = `{{.BaseURL}}/project/{{.ProjectID}}/experiments/{{.ExperimentID}}/runs/{{.ExperimentRunID}}`
+)
+
+// Format is the payload format of a webhook
+type Format string
+
+const (
+	// FormatGeneric posts the summary as JSON, with the rendered message in its "message" field
+	FormatGeneric Format = "generic"
+
+	// FormatSlack posts a Slack incoming webhook message
+	FormatSlack Format = "slack"
+
+	// FormatTeams posts a Microsoft Teams connector card
+	FormatTeams Format = "teams"
+)
+
+// Webhook is a single notification target
+type Webhook struct {
+	URL    string
+	Format Format
+
+	// Template is a text/template rendered with the Summary, defaults to DefaultMessage.
+	// The functions "join" (strings.Join) and "deref" (of a *float64) are available.
+	Template string
+
+	// Headers are added to every request, for example for authentication
+	Headers map[string]string
+}
+
+// Options configures a Notifier
+type Options struct {
+	Webhooks []Webhook
+
+	// UIBaseURL is the address of the ChaosCenter UI, no link is added to messages when empty
+	UIBaseURL string
+
+	// ProjectID is used to build the link to the run
+	ProjectID string
+
+	// LinkTemplate renders the link to a run, defaults to DefaultLink
+	LinkTemplate string
+
+	// Retries is the number of additional attempts after a failed delivery, defaults to 3; negative disables retries
+	Retries int
+
+	// Backoff is the wait before the first retry, doubled for every further one, defaults to one second
+	Backoff time.Duration
+
+	// HTTPClient sends the requests, defaults to a client with a 10 second timeout
+	HTTPClient *http.Client
+}
+
+// Summary describes a finished run
+type Summary struct {
+	ExperimentID    string    `json:"experimentID"`
+	ExperimentName  string    `json:"experimentName"`
+	ExperimentRunID string    `json:"experimentRunID"`
+	Phase           string    `json:"phase"`
+	ResiliencyScore *float64  `json:"resiliencyScore,omitempty"`
+	FaultsPassed    int       `json:"faultsPassed"`
+	FaultsFailed    int       `json:"faultsFailed"`
+	TotalFaults     int       `json:"totalFaults"`
+	FailedProbes    []string  `json:"failedProbes,omitempty"`
+	Link            string    `json:"link,omitempty"`
+	FinishedAt      time.Time `json:"finishedAt"`
+}
+
+// Succeeded reports whether the run completed without errors
+func (s Summary) Succeeded() bool {
+	return s.Phase == string(models.ExperimentRunStatusCompleted)
+}
+
+// Notifier delivers run summaries to webhooks
+type Notifier struct {
+	options   Options
+	link      *template.Template
+	templates []*template.Template
+}
+
+var funcs = template.FuncMap{
+	"join": strings.Join,
+	"deref": func(v *float64) float64 {
+		if v == nil {
+			return 0
+		}
+		return *v
+	},
+}
+
+// New creates a notifier, validating its webhooks and templates
+func New(options Options) (*Notifier, error) {
+	if len(options.Webhooks) == 0 {
+		return nil, fmt.Errorf("at least one webhook must be configured")
+	}
+	if options.Retries == 0 {
+		options.Retries = defaultRetries
+	} else if options.Retries < 0 {
+		options.Retries = 0
+	}
+	if options.Backoff <= 0 {
+		options.Backoff = defaultBackoff
+	}
+	if options.HTTPClient == nil {
+		options.HTTPClient = &http.Client{Timeout: defaultTimeout}
+	}
+	if options.LinkTemplate == "" {
+		options.LinkTemplate = DefaultLink
+	}
+
+	n := &Notifier{options: options}
+	var err error
+	if n.link, err = template.New("link").Parse(options.LinkTemplate); err != nil {
+		return nil, fmt.Errorf("invalid link template: %v", err)
+	}
+	// Rendering a sample catches references to unknown fields, which would
+	// otherwise only fail, without a link, once a run is summarized
+	if _, err := n.renderLink(sampleSummary()); err != nil {
+		return nil, fmt.Errorf("invalid link template: %v", err)
+	}
+
+	for i, webhook := range options.Webhooks {
+		if webhook.URL == "" {
+			return nil, fmt.Errorf("webhook %d has no URL", i)
+		}
+		switch webhook.Format {
+		case "", FormatGeneric, FormatSlack, FormatTeams:
+		default:
+			return nil, fmt.Errorf("webhook %d has unsupported format %q", i, webhook.Format)
+		}
+
+		text := webhook.Template
+		if text == "" {
+			text = DefaultMessage
+		}
+		tmpl, err := template.New(fmt.Sprintf("webhook-%d", i)).Funcs(funcs).Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid template of webhook %d: %v", i, err)
+		}
+		n.templates = append(n.templates, tmpl)
+	}
+
+	return n, nil
+}
+
+// Summarize builds the summary of a finished run
+func (n *Notifier) Summarize(run models.ExperimentRun) Summary {
+	summary := Summary{
+		ExperimentID:    run.ExperimentID,
+		ExperimentName:  run.ExperimentName,
+		ExperimentRunID: run.ExperimentRunID,
+		Phase:           string(run.Phase),
+		ResiliencyScore: run.ResiliencyScore,
+		FaultsPassed:    deref(run.FaultsPassed),
+		FaultsFailed:    deref(run.FaultsFailed),
+		TotalFaults:     deref(run.TotalFaults),
+		FinishedAt:      time.Now(),
+	}
+	if updatedAt, err := strconv.ParseInt(run.UpdatedAt, 10, 64); err == nil {
+		summary.FinishedAt = time.UnixMilli(updatedAt)
+	}
+
+	if data, err := experiment.ParseExecutionData(run); err == nil {
+		for _, node := range data.Nodes {
+			if node.ChaosData == nil || node.ChaosData.ChaosResult == nil {
+				continue
+			}
+			for _, status := range node.ChaosData.ChaosResult.Status.ProbeStatuses {
+				if status.Status.Verdict == "Failed" {
+					summary.FailedProbes = append(summary.FailedProbes, fmt.Sprintf("%s (%s)", status.Name, node.ChaosData.ExperimentName))
+				}
+			}
+		}
+		sort.Strings(summary.FailedProbes)
+	}
+
+	if n.options.UIBaseURL != "" {
+		if link, err := n.renderLink(summary); err == nil {
+			summary.Link = link
+		}
+	}
+
+	return summary
+}
+
+// renderLink renders the link to the run of a summary
+func (n *Notifier) renderLink(summary Summary) (string, error) {
+	var link bytes.Buffer
+	err := n.link.Execute(&link, struct {
+		BaseURL   string
+		ProjectID string
+		Summary
+	}{strings.TrimRight(n.options.UIBaseURL, "/"), n.options.ProjectID, summary})
+	return link.String(), err
+}
+
+// sampleSummary returns a summary with every field set, used to check templates
+func sampleSummary() Summary {
+	score := 100.0
+	return Summary{
+		ExperimentID:    "experiment",
+		ExperimentName:  "experiment",
+		ExperimentRunID: "run",
+		Phase:           string(models.ExperimentRunStatusCompleted),
+		ResiliencyScore: &score,
+		FaultsPassed:    1,
+		TotalFaults:     1,
+		FailedProbes:    []string{"probe"},
+		Link:            "link",
+		FinishedAt:      time.Unix(0, 0),
+	}
+}
+
+// Notify posts the summary of a finished run to every webhook
+func (n *Notifier) Notify(ctx context.Context, run models.ExperimentRun) error {
+	return n.Send(ctx, n.Summarize(run))
+}
+
+// WaitAndNotify waits for the run started with the given notify ID to finish and posts its summary
+func (n *Notifier) WaitAndNotify(ctx context.Context, experiments sdk.ExperimentClient, notifyID string, options sdk.WaitOptions) (models.ExperimentRun, error) {
+	run, err := experiments.WaitForRun(ctx, notifyID, options)
+	if err != nil {
+		return run, err
+	}
+	return run, n.Notify(ctx, run)
+}
+
+// Send posts a summary to every webhook. Deliveries are independent; the
+// errors of all failed webhooks are returned together.
+func (n *Notifier) Send(ctx context.Context, summary Summary) error {
+	var errs []error
+	for i, webhook := range n.options.Webhooks {
+		payload, err := n.payload(i, webhook, summary)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := n.deliver(ctx, webhook, payload); err != nil {
+			errs = append(errs, fmt.Errorf("failed to notify webhook %d: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// payload renders the message and wraps it in the format of the webhook
+func (n *Notifier) payload(i int, webhook Webhook, summary Summary) ([]byte, error) {
+	var message bytes.Buffer
+	if err := n.templates[i].Execute(&message, summary); err != nil {
+		return nil, fmt.Errorf("failed to render message of webhook %d: %v", i, err)
+	}
+
+	var body interface{}
+	switch webhook.Format {
+	case FormatSlack:
+		body = map[string]string{"text": message.String()}
+	case FormatTeams:
+		color := "2EB886"
+		if !summary.Succeeded() {
+			color = "D40E0D"
+		}
+		body = map[string]string{
+			"@type":      "MessageCard",
+			"@context":   "https://schema.org/extensions",
+			"summary":    fmt.Sprintf("Chaos experiment %s %s", summary.ExperimentName, summary.Phase),
+			"themeColor": color,
+			"title":      fmt.Sprintf("Chaos experiment %s", summary.ExperimentName),
+			"text":       message.String(),
+		}
+	default:
+		body = struct {
+			Summary
+			Message string `json:"message"`
+		}{summary, message.String()}
+	}
+
+	out, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload of webhook %d: %v", i, err)
+	}
+	return out, nil
+}
+
+// deliver posts the payload, retrying network errors, rate limiting and server errors with exponential backoff
+func (n *Notifier) deliver(ctx context.Context, webhook Webhook, payload []byte) error {
+	backoff := n.options.Backoff
+	var err error
+	for attempt := 0; ; attempt++ {
+		var retry bool
+		retry, err = n.post(ctx, webhook, payload)
+		if err == nil || !retry || attempt >= n.options.Retries {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w (last error: %v)", ctx.Err(), err)
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// post makes a single delivery attempt and reports whether a failure may be retried
+func (n *Notifier) post(ctx context.Context, webhook Webhook, payload []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(payload))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range webhook.Headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := n.options.HTTPClient.Do(req)
+	if err != nil {
+		return ctx.Err() == nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	err = fmt.Errorf("webhook responded with %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500, err
+}
+
+func deref(v *int) int {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
@@ -0,0 +1,179 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func testRun() models.ExperimentRun {
+	score := 62.5
+	failed := 1
+	return models.ExperimentRun{
+		ExperimentID:    "exp-1",
+		ExperimentName:  "checkout",
+		ExperimentRunID: "run-1",
+		Phase:           models.ExperimentRunStatusCompletedWithError,
+		ResiliencyScore: &score,
+		FaultsFailed:    &failed,
+		UpdatedAt:       "1735689600000",
+		ExecutionData: `{"nodes": {"n": {"chaosData": {"experimentName": "pod-delete", "chaosResult": {"status": {"probeStatuses": [
+			{"name": "checkout-http", "status": {"verdict": "Failed"}},
+			{"name": "latency", "status": {"verdict": "Passed"}}
+		]}}}}}}`,
+	}
+}
+
+// recorder is a webhook which answers with the given status codes in turn and records the bodies it received
+type recorder struct {
+	statuses []int
+	calls    atomic.Int32
+	bodies   [][]byte
+	headers  []http.Header
+}
+
+func (r *recorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	i := int(r.calls.Add(1)) - 1
+	body, _ := io.ReadAll(req.Body)
+	r.bodies = append(r.bodies, body)
+	r.headers = append(r.headers, req.Header)
+	status := http.StatusOK
+	if i < len(r.statuses) {
+		status = r.statuses[i]
+	}
+	w.WriteHeader(status)
+}
+
+func TestSummarize(t *testing.T) {
+	n, err := New(Options{Webhooks: []Webhook{{URL: "http://example.com"}}, UIBaseURL: "https://chaos.example.com/", ProjectID: "p1"})
+	assert.NoError(t, err)
+
+	summary := n.Summarize(testRun())
+	assert.Equal(t, []string{"checkout-http (pod-delete)"}, summary.FailedProbes)
+	assert.Equal(t, "https://chaos.example.com/project/p1/experiments/exp-1/runs/run-1", summary.Link)
+	assert.Equal(t, time.UnixMilli(1735689600000), summary.FinishedAt)
+	assert.Equal(t, 1, summary.FaultsFailed)
+	assert.False(t, summary.Succeeded())
+}
+
+func TestFormats(t *testing.T) {
+	tests := []struct {
+		name   string
+		format Format
+		check  func(t *testing.T, body map[string]interface{})
+	}{
+		{
+			name:   "generic",
+			format: FormatGeneric,
+			check: func(t *testing.T, body map[string]interface{}) {
+				assert.Equal(t, "checkout", body["experimentName"])
+				assert.Equal(t, 62.5, body["resiliencyScore"])
+				assert.Equal(t, "Chaos experiment checkout finished: Completed_With_Error, resiliency score 62.50. Failed probes: checkout-http (pod-delete)", body["message"])
+			},
+		},
+		{
+			name:   "slack",
+			format: FormatSlack,
+			check: func(t *testing.T, body map[string]interface{}) {
+				assert.Len(t, body, 1)
+				assert.Contains(t, body["text"], "resiliency score 62.50")
+			},
+		},
+		{
+			name:   "teams",
+			format: FormatTeams,
+			check: func(t *testing.T, body map[string]interface{}) {
+				assert.Equal(t, "MessageCard", body["@type"])
+				assert.Equal(t, "D40E0D", body["themeColor"])
+				assert.Contains(t, body["text"], "Failed probes")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hook := &recorder{}
+			server := httptest.NewServer(hook)
+			defer server.Close()
+
+			n, err := New(Options{Webhooks: []Webhook{{URL: server.URL, Format: tt.format, Headers: map[string]string{"Authorization": "Bearer t"}}}})
+			assert.NoError(t, err)
+			assert.NoError(t, n.Notify(context.Background(), testRun()))
+
+			if assert.Len(t, hook.bodies, 1) {
+				var body map[string]interface{}
+				assert.NoError(t, json.Unmarshal(hook.bodies[0], &body))
+				tt.check(t, body)
+				assert.Equal(t, "Bearer t", hook.headers[0].Get("Authorization"))
+			}
+		})
+	}
+}
+
+func TestTemplate(t *testing.T) {
+	hook := &recorder{}
+	server := httptest.NewServer(hook)
+	defer server.Close()
+
+	n, err := New(Options{Webhooks: []Webhook{{URL: server.URL, Format: FormatSlack, Template: "{{.ExperimentName}} scored {{printf \"%.0f\" (deref .ResiliencyScore)}}"}}})
+	assert.NoError(t, err)
+	assert.NoError(t, n.Notify(context.Background(), testRun()))
+	assert.JSONEq(t, `{"text": "checkout scored 62"}`, string(hook.bodies[0]))
+
+	_, err = New(Options{Webhooks: []Webhook{{URL: server.URL, Template: "{{.Missing"}}})
+	assert.ErrorContains(t, err, "invalid template of webhook 0")
+}
+
+func TestRetry(t *testing.T) {
+	tests := []struct {
+		name      string
+		statuses  []int
+		retries   int
+		wantCalls int32
+		wantErr   string
+	}{
+		{name: "recovers after server errors", statuses: []int{500, 429}, retries: 3, wantCalls: 3},
+		{name: "gives up after retries", statuses: []int{503, 503, 503}, retries: 2, wantCalls: 3, wantErr: "503 Service Unavailable"},
+		{name: "client errors are not retried", statuses: []int{400}, retries: 3, wantCalls: 1, wantErr: "400 Bad Request"},
+		{name: "retries disabled", statuses: []int{500}, retries: -1, wantCalls: 1, wantErr: "500 Internal Server Error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hook := &recorder{statuses: tt.statuses}
+			server := httptest.NewServer(hook)
+			defer server.Close()
+
+			n, err := New(Options{Webhooks: []Webhook{{URL: server.URL}}, Retries: tt.retries, Backoff: time.Millisecond})
+			assert.NoError(t, err)
+
+			err = n.Notify(context.Background(), testRun())
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantCalls, hook.calls.Load())
+		})
+	}
+}
+
+func TestNewValidates(t *testing.T) {
+	_, err := New(Options{})
+	assert.EqualError(t, err, "at least one webhook must be configured")
+
+	_, err = New(Options{Webhooks: []Webhook{{URL: "http://example.com", Format: "discord"}}})
+	assert.EqualError(t, err, `webhook 0 has unsupported format "discord"`)
+
+	_, err = New(Options{Webhooks: []Webhook{{URL: "http://example.com"}}, LinkTemplate: "{{.BaseURL}}/runs/{{.RunID}}"})
+	assert.ErrorContains(t, err, "invalid link template")
+	assert.ErrorContains(t, err, "RunID")
+}
@@ -0,0 +1,329 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package orchestrator runs many chaos experiments concurrently with a bounded
+// number of runs in flight and a request rate shared by all of them.
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/sdk"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+const (
+	defaultConcurrency  = 4
+	defaultPollInterval = 10 * time.Second
+)
+
+// Policy decides what happens to the remaining experiments once one fails
+type Policy int
+
+const (
+	// ContinueOnError runs every experiment regardless of failures
+	ContinueOnError Policy = iota
+
+	// FailFast stops in-flight runs and skips pending experiments after the first failure
+	FailFast
+)
+
+// Item is a single experiment to run
+type Item struct {
+	// ExperimentID is the experiment to run
+	ExperimentID string
+
+	// Request, if set, is saved before the experiment is run. Its ID defaults to ExperimentID.
+	Request *models.SaveChaosExperimentRequest
+}
+
+// ForExperiment returns an item which runs an existing experiment
+func ForExperiment(id string) Item {
+	return Item{ExperimentID: id}
+}
+
+// ForManifest returns an item which saves the experiment described by request and runs it
+func ForManifest(request models.SaveChaosExperimentRequest) Item {
+	return Item{ExperimentID: request.ID, Request: &request}
+}
+
+// Options configures an Orchestrator
+type Options struct {
+	// Concurrency limits the number of experiments running at the same time, defaults to 4
+	Concurrency int
+
+	// Policy decides how failures affect the remaining experiments, defaults to ContinueOnError
+	Policy Policy
+
+	// PollInterval is the interval between status checks of each run, defaults to 10 seconds
+	PollInterval time.Duration
+
+	// RequestInterval is the minimum time between two API requests across all runs, zero disables rate limiting
+	RequestInterval time.Duration
+
+	// RunTimeout bounds how long a single run may take, zero waits indefinitely
+	RunTimeout time.Duration
+}
+
+// Outcome is the result of running a single item
+type Outcome struct {
+	ExperimentID string
+	NotifyID     string
+	Run          models.ExperimentRun
+	Started      time.Time
+	Finished     time.Time
+	Skipped      bool
+	Err          error
+}
+
+// Succeeded reports whether the run completed without errors
+func (o Outcome) Succeeded() bool {
+	return o.Err == nil && !o.Skipped && o.Run.Phase == models.ExperimentRunStatusCompleted
+}
+
+// Report contains the outcomes of all items in the order they were given
+type Report struct {
+	Outcomes []Outcome
+}
+
+// Failed returns the outcomes of items which were run but did not succeed
+func (r Report) Failed() []Outcome {
+	var failed []Outcome
+	for _, outcome := range r.Outcomes {
+		if !outcome.Skipped && !outcome.Succeeded() {
+			failed = append(failed, outcome)
+		}
+	}
+	return failed
+}
+
+// Skipped returns the outcomes of items which were never started
+func (r Report) Skipped() []Outcome {
+	var skipped []Outcome
+	for _, outcome := range r.Outcomes {
+		if outcome.Skipped {
+			skipped = append(skipped, outcome)
+		}
+	}
+	return skipped
+}
+
+// String renders the report, one experiment per line
+func (r Report) String() string {
+	var sb strings.Builder
+	succeeded := 0
+	for _, outcome := range r.Outcomes {
+		var status string
+		switch {
+		case outcome.Skipped:
+			status = "skipped"
+		case outcome.Err != nil:
+			status = fmt.Sprintf("failed: %v", outcome.Err)
+		default:
+			status = string(outcome.Run.Phase)
+		}
+		if outcome.Succeeded() {
+			succeeded++
+		}
+
+		score := "-"
+		if outcome.Run.ResiliencyScore != nil {
+			score = fmt.Sprintf("%.2f", *outcome.Run.ResiliencyScore)
+		}
+		fmt.Fprintf(&sb, "%s\t%s\t%s\t%s\n", outcome.ExperimentID, outcome.Run.ExperimentRunID, score, status)
+	}
+	fmt.Fprintf(&sb, "%d of %d experiment(s) succeeded\n", succeeded, len(r.Outcomes))
+	return sb.String()
+}
+
+// Orchestrator runs experiments through an ExperimentClient
+type Orchestrator struct {
+	experiments sdk.ExperimentClient
+	options     Options
+}
+
+// New creates an orchestrator for the given experiment client
+func New(experiments sdk.ExperimentClient, options Options) *Orchestrator {
+	if options.Concurrency <= 0 {
+		options.Concurrency = defaultConcurrency
+	}
+	if options.PollInterval <= 0 {
+		options.PollInterval = defaultPollInterval
+	}
+	return &Orchestrator{experiments: experiments, options: options}
+}
+
+// Run runs all items and waits for them to finish. Items which could not be
+// started because the context was cancelled, or because an earlier item failed
+// under FailFast, are reported as skipped. An error is only returned for
+// invalid items; failed runs are reported in their outcome.
+func (o *Orchestrator) Run(ctx context.Context, items []Item) (Report, error) {
+	for i, item := range items {
+		if item.ExperimentID == "" && (item.Request == nil || item.Request.ID == "") {
+			return Report{}, fmt.Errorf("item %d has no experiment ID", i)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	report := Report{Outcomes: make([]Outcome, len(items))}
+	limit := newLimiter(o.options.RequestInterval)
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < o.options.Concurrency && w < len(items); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				outcome := &report.Outcomes[i]
+				if ctx.Err() != nil {
+					outcome.Skipped = true
+					continue
+				}
+				*outcome = o.runItem(ctx, limit, items[i])
+				if !outcome.Succeeded() && o.options.Policy == FailFast {
+					cancel()
+				}
+			}
+		}()
+	}
+
+	for i, item := range items {
+		report.Outcomes[i].ExperimentID = experimentID(item)
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return report, nil
+}
+
+// runItem saves and runs a single item and waits for its run to finish
+func (o *Orchestrator) runItem(ctx context.Context, limit *limiter, item Item) (outcome Outcome) {
+	outcome = Outcome{ExperimentID: experimentID(item), Started: time.Now()}
+	defer func() {
+		outcome.Finished = time.Now()
+	}()
+
+	if item.Request != nil {
+		if err := limit.wait(ctx); err != nil {
+			outcome.Skipped = true
+			return outcome
+		}
+		request := *item.Request
+		request.ID = outcome.ExperimentID
+		if _, err := o.experiments.Update(request.ID, request); err != nil {
+			outcome.Err = err
+			return outcome
+		}
+	}
+
+	if err := limit.wait(ctx); err != nil {
+		outcome.Skipped = true
+		return outcome
+	}
+	notifyID, err := o.experiments.Run(outcome.ExperimentID)
+	if err != nil {
+		outcome.Err = err
+		return outcome
+	}
+	outcome.NotifyID = notifyID
+
+	runCtx := ctx
+	if o.options.RunTimeout > 0 {
+		var cancel context.CancelFunc
+		runCtx, cancel = context.WithTimeout(ctx, o.options.RunTimeout)
+		defer cancel()
+	}
+
+	// The limiter shares the request rate with other runs, the last fetched run
+	// is kept since WaitForRun drops it when the final lookup fails
+	var observed models.ExperimentRun
+	outcome.Run, outcome.Err = o.experiments.WaitForRun(runCtx, notifyID, sdk.WaitOptions{
+		PollInterval: o.options.PollInterval,
+		Throttle:     limit.wait,
+		OnUpdate:     func(run models.ExperimentRun) { observed = run },
+	})
+	if outcome.Err != nil {
+		outcome.Run = observed
+		// The run is abandoned, either because it timed out or because another item failed
+		if err := o.stop(outcome); err != nil {
+			outcome.Err = fmt.Errorf("%w (stopping the run failed: %v)", outcome.Err, err)
+		}
+	}
+	return outcome
+}
+
+// stop stops the run of an abandoned item. Only the run started by the item is
+// stopped; all runs of the experiment are stopped when it was never observed.
+func (o *Orchestrator) stop(outcome Outcome) error {
+	if outcome.Run.ExperimentRunID != "" {
+		return o.experiments.StopRun(outcome.ExperimentID, outcome.Run.ExperimentRunID)
+	}
+	return o.experiments.Stop(outcome.ExperimentID)
+}
+
+func experimentID(item Item) string {
+	if item.ExperimentID == "" && item.Request != nil {
+		return item.Request.ID
+	}
+	return item.ExperimentID
+}
+
+// limiter spaces out requests made by concurrent runs
+type limiter struct {
+	interval time.Duration
+	mu       sync.Mutex
+	next     time.Time
+}
+
+func newLimiter(interval time.Duration) *limiter {
+	return &limiter{interval: interval}
+}
+
+// wait blocks until the caller may make a request or the context is done
+func (l *limiter) wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if l.interval <= 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	slot := l.next
+	if slot.Before(now) {
+		slot = now
+	}
+	l.next = slot.Add(l.interval)
+	l.mu.Unlock()
+
+	timer := time.NewTimer(slot.Sub(now))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
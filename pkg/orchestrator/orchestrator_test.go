@@ -0,0 +1,213 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/sdk"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeExperiments finishes every run after a number of polls with the phase configured for its experiment
+type fakeExperiments struct {
+	sdk.ExperimentClient
+	mu      sync.Mutex
+	phases  map[string]models.ExperimentRunStatus
+	runErrs map[string]error
+	// lookupErrs makes runs of an experiment impossible to look up
+	lookupErrs map[string]error
+	polls      map[string]int
+	saved      []string
+	// stopped holds experiments stopped as a whole, stoppedRuns single runs
+	stopped     []string
+	stoppedRuns []string
+	running     int32
+	maxInFly    int32
+	// inFlight holds polls back until this many runs have been started
+	inFlight int32
+}
+
+func newFakeExperiments() *fakeExperiments {
+	return &fakeExperiments{
+		phases:     map[string]models.ExperimentRunStatus{},
+		runErrs:    map[string]error{},
+		lookupErrs: map[string]error{},
+		polls:      map[string]int{},
+	}
+}
+
+func (f *fakeExperiments) Update(id string, _ models.SaveChaosExperimentRequest) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.saved = append(f.saved, id)
+	return "", nil
+}
+
+func (f *fakeExperiments) Run(id string) (string, error) {
+	if err := f.runErrs[id]; err != nil {
+		return "", err
+	}
+	n := atomic.AddInt32(&f.running, 1)
+	for {
+		max := atomic.LoadInt32(&f.maxInFly)
+		if n <= max || atomic.CompareAndSwapInt32(&f.maxInFly, max, n) {
+			break
+		}
+	}
+	return id, nil
+}
+
+func (f *fakeExperiments) GetRunByNotifyID(notifyID string) (models.ExperimentRun, error) {
+	for atomic.LoadInt32(&f.maxInFly) < f.inFlight {
+		time.Sleep(time.Millisecond)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.polls[notifyID]++
+	if err := f.lookupErrs[notifyID]; err != nil {
+		return models.ExperimentRun{}, err
+	}
+	phase, ok := f.phases[notifyID]
+	if !ok {
+		phase = models.ExperimentRunStatusCompleted
+	}
+	if phase != models.ExperimentRunStatusRunning && f.polls[notifyID] >= 2 {
+		atomic.AddInt32(&f.running, -1)
+		return models.ExperimentRun{ExperimentID: notifyID, ExperimentRunID: "run-" + notifyID, Phase: phase}, nil
+	}
+	return models.ExperimentRun{ExperimentID: notifyID, ExperimentRunID: "run-" + notifyID, Phase: models.ExperimentRunStatusRunning}, nil
+}
+
+// WaitForRun polls like the real client, honouring the options the orchestrator relies on
+func (f *fakeExperiments) WaitForRun(ctx context.Context, notifyID string, options sdk.WaitOptions) (models.ExperimentRun, error) {
+	for {
+		if err := options.Throttle(ctx); err != nil {
+			return models.ExperimentRun{}, err
+		}
+		run, err := f.GetRunByNotifyID(notifyID)
+		if err == nil {
+			options.OnUpdate(run)
+			if sdk.IsTerminalPhase(run.Phase) {
+				return run, nil
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return models.ExperimentRun{}, ctx.Err()
+		case <-time.After(options.PollInterval):
+		}
+	}
+}
+
+func (f *fakeExperiments) Stop(id string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.stopped = append(f.stopped, id)
+	atomic.AddInt32(&f.running, -1)
+	return nil
+}
+
+func (f *fakeExperiments) StopRun(_ string, runID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.stoppedRuns = append(f.stoppedRuns, runID)
+	atomic.AddInt32(&f.running, -1)
+	return nil
+}
+
+func TestRunContinueOnError(t *testing.T) {
+	experiments := newFakeExperiments()
+	experiments.phases["b"] = models.ExperimentRunStatusError
+	experiments.runErrs["c"] = errors.New("infra not active")
+
+	o := New(experiments, Options{Concurrency: 2, PollInterval: time.Millisecond})
+	report, err := o.Run(context.Background(), []Item{
+		ForExperiment("a"),
+		ForExperiment("b"),
+		ForExperiment("c"),
+		ForManifest(models.SaveChaosExperimentRequest{ID: "d"}),
+		ForExperiment("e"),
+	})
+	assert.NoError(t, err)
+
+	assert.Len(t, report.Outcomes, 5)
+	assert.True(t, report.Outcomes[0].Succeeded())
+	assert.Equal(t, models.ExperimentRunStatusError, report.Outcomes[1].Run.Phase)
+	assert.EqualError(t, report.Outcomes[2].Err, "infra not active")
+	assert.True(t, report.Outcomes[3].Succeeded())
+	assert.True(t, report.Outcomes[4].Succeeded())
+	assert.Len(t, report.Failed(), 2)
+	assert.Empty(t, report.Skipped())
+	assert.Equal(t, []string{"d"}, experiments.saved)
+	assert.LessOrEqual(t, atomic.LoadInt32(&experiments.maxInFly), int32(2))
+	assert.Contains(t, report.String(), "3 of 5 experiment(s) succeeded")
+}
+
+func TestRunFailFast(t *testing.T) {
+	experiments := newFakeExperiments()
+	experiments.phases["a"] = models.ExperimentRunStatusError
+	experiments.phases["b"] = models.ExperimentRunStatusRunning
+	experiments.inFlight = 2
+
+	o := New(experiments, Options{Concurrency: 2, Policy: FailFast, PollInterval: time.Millisecond})
+	report, err := o.Run(context.Background(), []Item{
+		ForExperiment("a"),
+		ForExperiment("b"),
+		ForExperiment("c"),
+		ForExperiment("d"),
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, models.ExperimentRunStatusError, report.Outcomes[0].Run.Phase)
+	assert.Len(t, report.Skipped(), 2)
+	assert.ErrorIs(t, report.Outcomes[1].Err, context.Canceled)
+	assert.Equal(t, "run-b", report.Outcomes[1].Run.ExperimentRunID)
+	assert.Equal(t, []string{"run-b"}, experiments.stoppedRuns, "only the run started by the orchestrator is stopped")
+	assert.Empty(t, experiments.stopped)
+}
+
+func TestRunTimeout(t *testing.T) {
+	experiments := newFakeExperiments()
+	experiments.phases["a"] = models.ExperimentRunStatusRunning
+
+	o := New(experiments, Options{PollInterval: time.Millisecond, RunTimeout: 20 * time.Millisecond})
+	report, err := o.Run(context.Background(), []Item{ForExperiment("a")})
+	assert.NoError(t, err)
+
+	assert.ErrorIs(t, report.Outcomes[0].Err, context.DeadlineExceeded)
+	assert.Equal(t, []string{"run-a"}, experiments.stoppedRuns)
+	assert.Empty(t, experiments.stopped)
+}
+
+func TestRunTimeoutBeforeRunIsObserved(t *testing.T) {
+	experiments := newFakeExperiments()
+	experiments.lookupErrs["a"] = errors.New("run not found")
+
+	o := New(experiments, Options{PollInterval: time.Millisecond, RunTimeout: 20 * time.Millisecond})
+	report, err := o.Run(context.Background(), []Item{ForExperiment("a")})
+	assert.NoError(t, err)
+
+	assert.ErrorIs(t, report.Outcomes[0].Err, context.DeadlineExceeded)
+	assert.Empty(t, experiments.stoppedRuns)
+	assert.Equal(t, []string{"a"}, experiments.stopped, "the experiment is stopped when its run was never seen")
+}
+
+func TestRunRejectsItemsWithoutID(t *testing.T) {
+	o := New(newFakeExperiments(), Options{})
+	_, err := o.Run(context.Background(), []Item{{}})
+	assert.EqualError(t, err, "item 0 has no experiment ID")
+}
+
+func TestLimiterSpacesRequests(t *testing.T) {
+	l := newLimiter(10 * time.Millisecond)
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		assert.NoError(t, l.wait(context.Background()))
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 30*time.Millisecond)
+}
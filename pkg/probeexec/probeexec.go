@@ -0,0 +1,417 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package probeexec runs probe definitions locally, so that a probe can be
+// tried out before it is attached to a chaos experiment. HTTP probes send
+// their request from this process, CMD probes run their command in a local
+// shell and PROM probes query a Prometheus compatible HTTP API. K8s probes and
+// CMD probes with a source need a cluster and are not supported.
+package probeexec
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/probe"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+// maxEvidence bounds the response body or command output kept in an attempt
+const maxEvidence = 4096
+
+// maxQueryResponse bounds the Prometheus response read to extract a sample,
+// which must be parsed whole even though only maxEvidence of it is kept
+const maxQueryResponse = 32 << 20
+
+// Options configures Run
+type Options struct {
+	// HTTPClient sends the requests of HTTP and PROM probes. By default a
+	// client honouring insecureSkipVerify of HTTP probes is used; a custom
+	// client is used as is.
+	HTTPClient *http.Client
+
+	// Shell runs the commands of CMD probes, the command is appended as the
+	// last argument. Defaults to /bin/sh -c.
+	Shell []string
+}
+
+// Attempt is the evidence of a single execution of a probe
+type Attempt struct {
+	Passed bool
+
+	// Observed is the value the probe checked: the HTTP response code, the
+	// command output or the query result. It is empty when the check could not run.
+	Observed string
+
+	// Message explains the outcome, e.g. `response code 200 == 200`
+	Message string
+
+	// Output is the beginning of the HTTP response body or of the command's
+	// standard error, to help understand a failure
+	Output string
+
+	Duration time.Duration
+}
+
+// Result is the verdict of a probe run along with the evidence of every attempt
+type Result struct {
+	Name    string
+	Verdict models.ProbeVerdict
+
+	// Attempts ends with the first passing attempt
+	Attempts []Attempt
+}
+
+// Passed reports whether an attempt of the probe passed
+func (r Result) Passed() bool {
+	return r.Verdict == models.ProbeVerdictPassed
+}
+
+// Evidence returns the message of the last attempt
+func (r Result) Evidence() string {
+	if len(r.Attempts) == 0 {
+		return ""
+	}
+	return r.Attempts[len(r.Attempts)-1].Message
+}
+
+// check runs a probe once. Failures to reach the target are failed attempts
+// rather than errors, as they are what a probe is meant to detect.
+type check func(ctx context.Context) Attempt
+
+// Run executes a probe definition the way the probe runtime does: after the
+// initial delay, up to attempt tries separated by interval, each bounded by
+// probeTimeout, until one passes. An error is returned when the definition is
+// invalid or cannot run locally, or when ctx is done.
+func Run(ctx context.Context, request probe.ProbeRequest, options Options) (Result, error) {
+	if err := request.Validate(); err != nil {
+		return Result{}, err
+	}
+
+	var (
+		c   check
+		run runSettings
+		err error
+	)
+	switch request.Type {
+	case probe.ProbeTypeHTTPProbe:
+		p := request.KubernetesHTTPProperties
+		run, err = settings(p.ProbeTimeout, p.Interval, p.Attempt, p.Retry, p.InitialDelay)
+		if err == nil {
+			c, err = httpCheck(p, options)
+		}
+	case probe.ProbeTypeCMDProbe:
+		p := request.KubernetesCMDProperties
+		run, err = settings(p.ProbeTimeout, p.Interval, p.Attempt, p.Retry, p.InitialDelay)
+		if err == nil {
+			c, err = cmdCheck(p, options)
+		}
+	case probe.ProbeTypePROMProbe:
+		p := request.PROMProperties
+		run, err = settings(p.ProbeTimeout, p.Interval, p.Attempt, p.Retry, p.InitialDelay)
+		if err == nil {
+			c, err = promCheck(p, options)
+		}
+	default:
+		err = fmt.Errorf("%s probes cannot be run locally", request.Type)
+	}
+	if err != nil {
+		return Result{}, err
+	}
+
+	return run.execute(ctx, request.Name, c)
+}
+
+type runSettings struct {
+	timeout      time.Duration
+	interval     time.Duration
+	initialDelay time.Duration
+	attempts     int
+}
+
+func settings(timeout, interval string, attempt, retry *int, initialDelay *string) (runSettings, error) {
+	s := runSettings{attempts: 1}
+	var err error
+	if s.timeout, err = time.ParseDuration(timeout); err != nil {
+		return s, fmt.Errorf("invalid probe timeout: %w", err)
+	}
+	if s.interval, err = time.ParseDuration(interval); err != nil {
+		return s, fmt.Errorf("invalid interval: %w", err)
+	}
+	if initialDelay != nil && *initialDelay != "" {
+		if s.initialDelay, err = time.ParseDuration(*initialDelay); err != nil {
+			return s, fmt.Errorf("invalid initial delay: %w", err)
+		}
+	}
+
+	// retry is the older name of attempt
+	switch {
+	case attempt != nil && *attempt > 0:
+		s.attempts = *attempt
+	case retry != nil && *retry > 0:
+		s.attempts = *retry
+	}
+	return s, nil
+}
+
+func (s runSettings) execute(ctx context.Context, name string, c check) (Result, error) {
+	result := Result{Name: name, Verdict: models.ProbeVerdictFailed}
+	if err := sleep(ctx, s.initialDelay); err != nil {
+		return result, err
+	}
+
+	for i := 0; i < s.attempts; i++ {
+		if i > 0 {
+			if err := sleep(ctx, s.interval); err != nil {
+				return result, err
+			}
+		}
+
+		attemptCtx, cancel := context.WithTimeout(ctx, s.timeout)
+		start := time.Now()
+		attempt := c(attemptCtx)
+		attempt.Duration = time.Since(start)
+		cancel()
+
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+		result.Attempts = append(result.Attempts, attempt)
+		if attempt.Passed {
+			result.Verdict = models.ProbeVerdictPassed
+			break
+		}
+	}
+	return result, nil
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func httpCheck(p *probe.KubernetesHTTPProbeRequest, options Options) (check, error) {
+	method, criteria, code := http.MethodGet, "", ""
+	var body []byte
+	var contentType string
+	if get := p.Method.Get; get != nil {
+		criteria, code = get.Criteria, get.ResponseCode
+	} else {
+		post := p.Method.Post
+		method, criteria, code = http.MethodPost, post.Criteria, post.ResponseCode
+		switch {
+		case post.Body != nil:
+			body = []byte(*post.Body)
+		case post.BodyPath != nil && *post.BodyPath != "":
+			var err error
+			if body, err = os.ReadFile(*post.BodyPath); err != nil {
+				return nil, fmt.Errorf("failed to read request body: %w", err)
+			}
+		}
+		if post.ContentType != nil {
+			contentType = *post.ContentType
+		}
+	}
+	comparator := probe.ComparatorInput{Type: probe.ComparatorTypeInt, Criteria: criteria, Value: code}
+
+	client := options.HTTPClient
+	if client == nil {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		if p.InsecureSkipVerify != nil && *p.InsecureSkipVerify {
+			transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+		}
+		client = &http.Client{Transport: transport}
+	}
+
+	return func(ctx context.Context) Attempt {
+		req, err := http.NewRequestWithContext(ctx, method, p.URL, bytes.NewReader(body))
+		if err != nil {
+			return Attempt{Message: err.Error()}
+		}
+		if contentType != "" {
+			req.Header.Set("Content-Type", contentType)
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return Attempt{Message: err.Error()}
+		}
+		defer resp.Body.Close()
+		output, _ := io.ReadAll(io.LimitReader(resp.Body, maxEvidence))
+
+		observed := strconv.Itoa(resp.StatusCode)
+		attempt := compare("response code", observed, comparator)
+		attempt.Output = string(output)
+		return attempt
+	}, nil
+}
+
+func cmdCheck(p *probe.KubernetesCMDProbeRequest, options Options) (check, error) {
+	if p.Source != nil && *p.Source != "" {
+		return nil, fmt.Errorf("cmd probes with a source run in their own pod and cannot be run locally")
+	}
+	shell := options.Shell
+	if len(shell) == 0 {
+		shell = []string{"/bin/sh", "-c"}
+	}
+	comparator := *p.Comparator
+
+	return func(ctx context.Context) Attempt {
+		var stdout, stderr strings.Builder
+		args := append(append([]string{}, shell[1:]...), p.Command)
+		cmd := exec.CommandContext(ctx, shell[0], args...)
+		cmd.Stdout, cmd.Stderr = &stdout, &stderr
+
+		if err := cmd.Run(); err != nil {
+			return Attempt{Message: fmt.Sprintf("command failed: %v", err), Output: truncate(stderr.String())}
+		}
+		attempt := compare("output", strings.TrimSpace(stdout.String()), comparator)
+		attempt.Output = truncate(stderr.String())
+		return attempt
+	}, nil
+}
+
+func promCheck(p *probe.PROMProbeRequest, options Options) (check, error) {
+	query := p.Query
+	if query == "" {
+		content, err := os.ReadFile(*p.QueryPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read query: %w", err)
+		}
+		query = strings.TrimSpace(string(content))
+	}
+	endpoint := strings.TrimSuffix(p.Endpoint, "/") + "/api/v1/query?" + url.Values{"query": {query}}.Encode()
+	comparator := *p.Comparator
+
+	client := options.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return func(ctx context.Context) Attempt {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+		if err != nil {
+			return Attempt{Message: err.Error()}
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return Attempt{Message: err.Error()}
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxQueryResponse))
+
+		value, err := promValue(resp.StatusCode, body)
+		if err != nil {
+			return Attempt{Message: err.Error(), Output: truncate(string(body))}
+		}
+		return compare("query result", value, comparator)
+	}, nil
+}
+
+// promValue extracts the first sample of a Prometheus instant query response
+func promValue(status int, body []byte) (string, error) {
+	var response struct {
+		Status string `json:"status"`
+		Error  string `json:"error"`
+		Data   struct {
+			ResultType string          `json:"resultType"`
+			Result     json.RawMessage `json:"result"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return "", fmt.Errorf("invalid query response (HTTP %d): %v", status, err)
+	}
+	if response.Status != "success" {
+		return "", fmt.Errorf("query failed (HTTP %d): %s", status, response.Error)
+	}
+
+	// Samples are [timestamp, "value"] pairs
+	var sample []interface{}
+	switch response.Data.ResultType {
+	case "scalar":
+		if err := json.Unmarshal(response.Data.Result, &sample); err != nil {
+			return "", fmt.Errorf("invalid scalar result: %v", err)
+		}
+	case "vector":
+		var vector []struct {
+			Value []interface{} `json:"value"`
+		}
+		if err := json.Unmarshal(response.Data.Result, &vector); err != nil {
+			return "", fmt.Errorf("invalid vector result: %v", err)
+		}
+		if len(vector) == 0 {
+			return "", errors.New("query returned no samples")
+		}
+		sample = vector[0].Value
+	default:
+		return "", fmt.Errorf("unsupported result type %q, expected a scalar or an instant vector", response.Data.ResultType)
+	}
+
+	if len(sample) != 2 {
+		return "", fmt.Errorf("invalid sample %v", sample)
+	}
+	value, ok := sample[1].(string)
+	if !ok {
+		return "", fmt.Errorf("invalid sample value %v", sample[1])
+	}
+	return value, nil
+}
+
+// compare builds the attempt checking an observed value against a comparator
+func compare(subject, observed string, comparator probe.ComparatorInput) Attempt {
+	attempt := Attempt{Observed: observed}
+	passed, err := comparator.Match(observed)
+	if err != nil {
+		attempt.Message = fmt.Sprintf("%s %q: %v", subject, observed, err)
+		return attempt
+	}
+
+	attempt.Passed = passed
+	operator := comparator.Criteria
+	if !passed {
+		operator = "not " + operator
+	}
+	attempt.Message = fmt.Sprintf("%s %s %s %s", subject, observed, operator, comparator.Value)
+	return attempt
+}
+
+func truncate(s string) string {
+	if len(s) > maxEvidence {
+		return s[:maxEvidence]
+	}
+	return s
+}
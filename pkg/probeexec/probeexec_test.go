@@ -0,0 +1,210 @@
+package probeexec
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/probe"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunHTTPProbe(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, r.Method+" "+r.Header.Get("Content-Type")+" "+string(body))
+		if r.URL.Path == "/missing" {
+			http.NotFound(w, r)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		request  *probe.HTTPProbeBuilder
+		verdict  models.ProbeVerdict
+		evidence string
+	}{
+		{
+			name:     "get passes",
+			request:  probe.NewHTTPProbe("h", server.URL).Get("==", "201"),
+			verdict:  models.ProbeVerdictPassed,
+			evidence: "response code 201 == 201",
+		},
+		{
+			name:     "get oneOf fails",
+			request:  probe.NewHTTPProbe("h", server.URL+"/missing").Get("oneOf", "[200,201]"),
+			verdict:  models.ProbeVerdictFailed,
+			evidence: "response code 404 not oneOf [200,201]",
+		},
+		{
+			name:     "post not equal passes",
+			request:  probe.NewHTTPProbe("h", server.URL).Post("application/json", `{"ping":true}`, "!=", "500"),
+			verdict:  models.ProbeVerdictPassed,
+			evidence: "response code 201 != 500",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request, err := tt.request.Build()
+			require.NoError(t, err)
+			result, err := Run(context.Background(), request, Options{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.verdict, result.Verdict)
+			assert.Equal(t, tt.evidence, result.Evidence())
+			require.Len(t, result.Attempts, 1)
+		})
+	}
+	assert.Equal(t, `POST application/json {"ping":true}`, bodies[len(bodies)-1])
+}
+
+func TestRunHTTPProbeInsecureSkipVerify(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	request, err := probe.NewHTTPProbe("h", server.URL).Get("==", "200").Build()
+	require.NoError(t, err)
+	result, err := Run(context.Background(), request, Options{})
+	require.NoError(t, err)
+	assert.False(t, result.Passed())
+	assert.Contains(t, result.Evidence(), "certificate")
+
+	request, err = probe.NewHTTPProbe("h", server.URL).Get("==", "200").InsecureSkipVerify().Build()
+	require.NoError(t, err)
+	result, err = Run(context.Background(), request, Options{})
+	require.NoError(t, err)
+	assert.True(t, result.Passed(), result.Evidence())
+}
+
+func TestRunRetriesUntilPassing(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, "warming up")
+		}
+	}))
+	defer server.Close()
+
+	request, err := probe.NewHTTPProbe("h", server.URL).Get("==", "200").Attempt(5).Interval(time.Millisecond).Build()
+	require.NoError(t, err)
+	result, err := Run(context.Background(), request, Options{})
+	require.NoError(t, err)
+	assert.True(t, result.Passed())
+	require.Len(t, result.Attempts, 3)
+	assert.Equal(t, "503", result.Attempts[0].Observed)
+	assert.Equal(t, "warming up", result.Attempts[0].Output)
+}
+
+func TestRunCMDProbe(t *testing.T) {
+	request, err := probe.NewCMDProbe("c", "echo 42").Comparator(probe.ComparatorTypeInt, "between", "[40,50]").Build()
+	require.NoError(t, err)
+	result, err := Run(context.Background(), request, Options{})
+	require.NoError(t, err)
+	assert.True(t, result.Passed())
+	assert.Equal(t, "output 42 between [40,50]", result.Evidence())
+
+	request, err = probe.NewCMDProbe("c", "echo ready").Comparator(probe.ComparatorTypeInt, ">", "0").Build()
+	require.NoError(t, err)
+	result, err = Run(context.Background(), request, Options{})
+	require.NoError(t, err)
+	assert.False(t, result.Passed())
+	assert.Equal(t, `output "ready": "ready" is not an integer`, result.Evidence())
+
+	request, err = probe.NewCMDProbe("c", "echo broken >&2; exit 3").Comparator(probe.ComparatorTypeString, "equal", "ok").Build()
+	require.NoError(t, err)
+	result, err = Run(context.Background(), request, Options{})
+	require.NoError(t, err)
+	assert.False(t, result.Passed())
+	assert.Equal(t, "command failed: exit status 3", result.Evidence())
+	assert.Equal(t, "broken\n", result.Attempts[0].Output)
+}
+
+func TestRunPROMProbe(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/query", r.URL.Path)
+		switch r.URL.Query().Get("query") {
+		case "latency":
+			fmt.Fprint(w, `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000,"0.25"]}]}}`)
+		case "scalar(1)":
+			fmt.Fprint(w, `{"status":"success","data":{"resultType":"scalar","result":[1700000000,"1"]}}`)
+		case "large":
+			// A vector far larger than the evidence kept in an attempt
+			var series []string
+			for i := 0; i < 500; i++ {
+				series = append(series, fmt.Sprintf(`{"metric":{"__name__":"up","pod":"checkout-%d"},"value":[1700000000,"1"]}`, i))
+			}
+			fmt.Fprintf(w, `{"status":"success","data":{"resultType":"vector","result":[%s]}}`, strings.Join(series, ","))
+		case "absent":
+			fmt.Fprint(w, `{"status":"success","data":{"resultType":"vector","result":[]}}`)
+		default:
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"status":"error","errorType":"execution","error":"query processing would load too many samples"}`)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		query    string
+		criteria string
+		value    string
+		passed   bool
+		evidence string
+	}{
+		{query: "latency", criteria: "<=", value: "0.5", passed: true, evidence: "query result 0.25 <= 0.5"},
+		{query: "latency", criteria: ">", value: "0.5", evidence: "query result 0.25 not > 0.5"},
+		{query: "scalar(1)", criteria: "==", value: "1", passed: true, evidence: "query result 1 == 1"},
+		{query: "large", criteria: "==", value: "1", passed: true, evidence: "query result 1 == 1"},
+		{query: "absent", criteria: "==", value: "1", evidence: "query returned no samples"},
+		{query: "count(expensive)", criteria: "==", value: "1", evidence: "query failed (HTTP 422): query processing would load too many samples"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query+tt.criteria, func(t *testing.T) {
+			request, err := probe.NewPROMProbe("p", server.URL, tt.query).Comparator(tt.criteria, tt.value).Build()
+			require.NoError(t, err)
+			result, err := Run(context.Background(), request, Options{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.passed, result.Passed())
+			assert.Equal(t, tt.evidence, result.Evidence())
+		})
+	}
+}
+
+func TestRunUnsupported(t *testing.T) {
+	request, err := probe.NewK8SProbe("k", "v1", "pods", probe.K8SOperationPresent).Build()
+	require.NoError(t, err)
+	_, err = Run(context.Background(), request, Options{})
+	assert.EqualError(t, err, "k8sProbe probes cannot be run locally")
+
+	_, err = Run(context.Background(), probe.ProbeRequest{Name: "h", Type: probe.ProbeTypeHTTPProbe}, Options{})
+	assert.Error(t, err)
+}
+
+func TestRunCancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	request, err := probe.NewHTTPProbe("h", server.URL).Get("==", "200").Attempt(3).Interval(time.Hour).Build()
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	result, err := Run(ctx, request, Options{})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Len(t, result.Attempts, 1)
+}
@@ -0,0 +1,67 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ResourceKind is the kind of a reconciled resource
+type ResourceKind string
+
+const (
+	KindEnvironment ResourceKind = "environment"
+	KindProbe       ResourceKind = "probe"
+	KindExperiment  ResourceKind = "experiment"
+)
+
+// ChangeAction is what reconciling a resource does with it
+type ChangeAction string
+
+const (
+	ActionCreate ChangeAction = "create"
+	ActionUpdate ChangeAction = "update"
+	ActionDelete ChangeAction = "delete"
+	ActionNoOp   ChangeAction = "no-op"
+)
+
+var actionSymbols = map[ChangeAction]string{
+	ActionCreate: "+",
+	ActionUpdate: "~",
+	ActionDelete: "-",
+	ActionNoOp:   "=",
+}
+
+// Change is a change to a single resource, made or planned when reconciling
+// it with a definition such as a probe file or an apply spec
+type Change struct {
+	Kind   ResourceKind
+	Name   string
+	Action ChangeAction
+
+	// Fields lists what differs from the server for updates
+	Fields []string
+}
+
+// String renders the change as a single line
+func (c Change) String() string {
+	line := fmt.Sprintf("%s %s %s", actionSymbols[c.Action], c.Kind, c.Name)
+	if len(c.Fields) > 0 {
+		line += " (" + strings.Join(c.Fields, ", ") + ")"
+	}
+	return line
+}
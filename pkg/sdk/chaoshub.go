@@ -0,0 +1,103 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"fmt"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/chaoshub"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/types"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+// ChaosHubClient defines the interface for ChaosHub operations
+type ChaosHubClient interface {
+	// List retrieves all ChaosHubs connected to the project
+	List() ([]*models.ChaosHubStatus, error)
+
+	// Create connects a git ChaosHub to the project
+	Create(request models.CreateChaosHubRequest) (models.ChaosHub, error)
+
+	// CreateRemote connects a ChaosHub downloaded from a remote archive to the project
+	CreateRemote(request models.CreateRemoteChaosHub) (models.ChaosHub, error)
+}
+
+// chaosHubClient implements the ChaosHubClient interface
+type chaosHubClient struct {
+	credentials types.Credentials
+}
+
+// List retrieves all ChaosHubs connected to the project
+func (c *chaosHubClient) List() ([]*models.ChaosHubStatus, error) {
+	if c.credentials.Endpoint == "" {
+		return nil, fmt.Errorf("endpoint not set in credentials")
+	}
+
+	if c.credentials.ProjectID == "" {
+		return nil, fmt.Errorf("project ID not set in credentials")
+	}
+
+	response, err := chaoshub.ListChaosHub(c.credentials.ProjectID, c.credentials)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list chaos hubs: %w", err)
+	}
+
+	return response.ListChaosHub, nil
+}
+
+// Create connects a git ChaosHub to the project
+func (c *chaosHubClient) Create(request models.CreateChaosHubRequest) (models.ChaosHub, error) {
+	if c.credentials.Endpoint == "" {
+		return models.ChaosHub{}, fmt.Errorf("endpoint not set in credentials")
+	}
+
+	if c.credentials.ProjectID == "" {
+		return models.ChaosHub{}, fmt.Errorf("project ID not set in credentials")
+	}
+
+	if request.Name == "" {
+		return models.ChaosHub{}, fmt.Errorf("chaos hub name cannot be empty")
+	}
+
+	response, err := chaoshub.AddChaosHub(c.credentials.ProjectID, request, c.credentials)
+	if err != nil {
+		return models.ChaosHub{}, fmt.Errorf("failed to create chaos hub: %w", err)
+	}
+
+	return response.AddChaosHub, nil
+}
+
+// CreateRemote connects a ChaosHub downloaded from a remote archive to the project
+func (c *chaosHubClient) CreateRemote(request models.CreateRemoteChaosHub) (models.ChaosHub, error) {
+	if c.credentials.Endpoint == "" {
+		return models.ChaosHub{}, fmt.Errorf("endpoint not set in credentials")
+	}
+
+	if c.credentials.ProjectID == "" {
+		return models.ChaosHub{}, fmt.Errorf("project ID not set in credentials")
+	}
+
+	if request.Name == "" {
+		return models.ChaosHub{}, fmt.Errorf("chaos hub name cannot be empty")
+	}
+
+	response, err := chaoshub.AddRemoteChaosHub(c.credentials.ProjectID, request, c.credentials)
+	if err != nil {
+		return models.ChaosHub{}, fmt.Errorf("failed to create remote chaos hub: %w", err)
+	}
+
+	return response.AddRemoteChaosHub, nil
+}
@@ -41,6 +41,12 @@ type Client interface {
 
 	// Probe operations
 	Probes() ProbeClient
+
+	// ChaosHub operations
+	ChaosHubs() ChaosHubClient
+
+	// Image registry operations
+	ImageRegistries() ImageRegistryClient
 }
 
 // ClientOptions contains configuration for the API client
@@ -60,6 +66,8 @@ type LitmusClient struct {
 	experimentClient     ExperimentClient
 	infrastructureClient InfrastructureClient
 	probeClient          ProbeClient
+	chaosHubClient       ChaosHubClient
+	imageRegistryClient  ImageRegistryClient
 }
 
 // NewClient creates a new Litmus API client
@@ -90,6 +98,8 @@ func NewClient(options ClientOptions) (Client, error) {
 	client.experimentClient = &experimentClient{credentials: credentials}
 	client.infrastructureClient = &infrastructureClient{credentials: credentials}
 	client.probeClient = &probeClient{credentials: credentials}
+	client.chaosHubClient = &chaosHubClient{credentials: credentials}
+	client.imageRegistryClient = &imageRegistryClient{credentials: credentials}
 
 	return client, nil
 }
@@ -123,3 +133,13 @@ func (c *LitmusClient) Infrastructure() InfrastructureClient {
 func (c *LitmusClient) Probes() ProbeClient {
 	return c.probeClient
 }
+
+// ChaosHubs returns a ChaosHubClient for ChaosHub operations
+func (c *LitmusClient) ChaosHubs() ChaosHubClient {
+	return c.chaosHubClient
+}
+
+// ImageRegistries returns an ImageRegistryClient for image registry operations
+func (c *LitmusClient) ImageRegistries() ImageRegistryClient {
+	return c.imageRegistryClient
+}
@@ -31,6 +31,9 @@ type EnvironmentClient interface {
 	// Create creates a new environment
 	Create(name string, request models.CreateEnvironmentRequest) (models.Environment, error)
 
+	// Update changes the name, description, type or tags of an environment
+	Update(request models.UpdateEnvironmentRequest) error
+
 	// Delete removes an environment
 	Delete(id string) error
 
@@ -85,6 +88,28 @@ func (c *environmentClient) Create(name string, request models.CreateEnvironment
 	return response.CreateEnvironment, nil
 }
 
+// Update changes the name, description, type or tags of an environment
+func (c *environmentClient) Update(request models.UpdateEnvironmentRequest) error {
+	if c.credentials.Endpoint == "" {
+		return fmt.Errorf("endpoint not set in credentials")
+	}
+
+	if c.credentials.ProjectID == "" {
+		return fmt.Errorf("project ID not set in credentials")
+	}
+
+	if request.EnvironmentID == "" {
+		return fmt.Errorf("environment ID cannot be empty")
+	}
+
+	_, err := environment.UpdateEnvironment(c.credentials.ProjectID, request, c.credentials)
+	if err != nil {
+		return fmt.Errorf("failed to update environment: %w", err)
+	}
+
+	return nil
+}
+
 // Delete removes an environment
 func (c *environmentClient) Delete(id string) error {
 	if c.credentials.Endpoint == "" {
@@ -16,9 +16,13 @@ limitations under the License.
 package sdk
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/experiment"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/bundle"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/manifest"
 	"github.com/litmuschaos/litmus-go-sdk/pkg/types"
 	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
 )
@@ -34,6 +38,15 @@ type ExperimentClient interface {
 	// Delete removes an experiment
 	Delete(id string) error
 
+	// Clone copies an experiment, optionally into another project and onto another infra
+	Clone(id string, options CloneOptions) (models.SaveChaosExperimentRequest, error)
+
+	// Export builds a portable bundle from experiments and the probes they reference
+	Export(ids []string) (bundle.Bundle, error)
+
+	// Import recreates the experiments and probes of a bundle in a project
+	Import(b bundle.Bundle, options ImportOptions) (ImportResult, error)
+
 	// Update updates an experiment
 	Update(id string, experimentConfig models.SaveChaosExperimentRequest) (string, error)
 
@@ -46,8 +59,77 @@ type ExperimentClient interface {
 	// GetRunPhase retrieves just the status/phase of a specific experiment run
 	GetRunPhase(runID string) (string, error)
 
+	// GetRunByNotifyID retrieves an experiment run, including its execution data, by the notify ID returned by Run
+	GetRunByNotifyID(notifyID string) (models.ExperimentRun, error)
+
+	// WaitForRun polls the run started with the given notify ID until it finishes
+	WaitForRun(ctx context.Context, notifyID string, options WaitOptions) (models.ExperimentRun, error)
+
+	// Watch streams changes to experiment runs until the context is done
+	Watch(ctx context.Context, filter WatchFilter) (<-chan RunEvent, error)
+
+	// Stop stops all ongoing runs of an experiment
+	Stop(id string) error
+
+	// StopRun stops a single run of an experiment
+	StopRun(experimentID string, runID string) error
+
 	// ListRuns retrieves all experiment runs
 	ListRuns(request models.ListExperimentRunRequest) (models.ListExperimentRunResponse, error)
+
+	// DeleteRun removes a single run of an experiment
+	DeleteRun(experimentID string, runID string) error
+
+	// PruneRuns removes experiment runs according to a retention policy
+	PruneRuns(options PruneRunsOptions) (PruneRunsResult, error)
+
+	// Select retrieves all experiments matching a selector
+	Select(selector ExperimentSelector) ([]*models.Experiment, error)
+
+	// BulkDelete removes all experiments matching a selector
+	BulkDelete(selector ExperimentSelector, options BulkOptions) (BulkResult, error)
+
+	// BulkRun starts all experiments matching a selector
+	BulkRun(selector ExperimentSelector, options BulkOptions) (BulkResult, error)
+
+	// BulkRetag adds and removes tags on all experiments matching a selector
+	BulkRetag(selector ExperimentSelector, change TagChange, options BulkOptions) (BulkResult, error)
+
+	// CreateCron saves an experiment which runs on a cron schedule
+	CreateCron(name string, schedule CronSchedule, experimentConfig models.SaveChaosExperimentRequest) (string, error)
+
+	// PauseSchedule disables the schedule of a cron experiment
+	PauseSchedule(id string) error
+
+	// ResumeSchedule enables the schedule of a cron experiment
+	ResumeSchedule(id string) error
+
+	// ListScheduled retrieves all cron experiments along with their schedules
+	ListScheduled() ([]ScheduledExperiment, error)
+
+	// PreviewSchedule computes the next fire times of a cron experiment locally
+	PreviewSchedule(id string, count int) ([]time.Time, error)
+
+	// GetStats retrieves experiment statistics of the project
+	GetStats() (models.GetExperimentStatsResponse, error)
+
+	// GetRunStats retrieves experiment run statistics of the project
+	GetRunStats() (models.GetExperimentRunStatsResponse, error)
+
+	// ResiliencyTrend aggregates resiliency scores of experiment runs over a time window
+	ResiliencyTrend(options ResiliencyTrendOptions) (ResiliencyTrend, error)
+
+	// ListRevisions retrieves the manifest revisions of an experiment, oldest first
+	ListRevisions(id string) ([]experiment.ExperimentRevision, error)
+
+	// GetRevision retrieves a specific manifest revision of an experiment
+	GetRevision(id string, revisionID string) (experiment.ExperimentRevision, error)
+
+	// DiffRevisions computes the structural difference between two revisions of an experiment
+	DiffRevisions(id string, fromRevisionID string, toRevisionID string) (manifest.Diff, error)
+
+	// DiffManifest computes the structural difference between the server copy of an experiment and a local manifest
+	DiffManifest(id string, localManifest string) (manifest.Diff, error)
 }
 
 // experimentClient implements the ExperimentClient interface
@@ -152,6 +234,36 @@ func (c *experimentClient) Delete(id string) error {
 	return nil
 }
 
+// DeleteRun removes a single run of an experiment
+func (c *experimentClient) DeleteRun(experimentID string, runID string) error {
+	if c.credentials.Endpoint == "" {
+		return fmt.Errorf("endpoint not set in credentials")
+	}
+
+	if c.credentials.ProjectID == "" {
+		return fmt.Errorf("project ID not set in credentials")
+	}
+
+	if experimentID == "" {
+		return fmt.Errorf("experiment ID cannot be empty")
+	}
+
+	if runID == "" {
+		return fmt.Errorf("experiment run ID cannot be empty")
+	}
+
+	response, err := experiment.DeleteChaosExperimentRun(c.credentials.ProjectID, experimentID, runID, c.credentials)
+	if err != nil {
+		return fmt.Errorf("failed to delete experiment run: %w", err)
+	}
+
+	if !response.IsDeleted {
+		return fmt.Errorf("experiment run deletion was not successful")
+	}
+
+	return nil
+}
+
 // Update updates an experiment
 func (c *experimentClient) Update(id string, experimentConfig models.SaveChaosExperimentRequest) (string, error) {
 	if c.credentials.Endpoint == "" {
@@ -234,3 +346,54 @@ func (c *experimentClient) GetRunPhase(runID string) (string, error) {
 	return string(experimentRun.Phase), nil
 }
 
+
+// runPageSize is the number of experiment runs fetched per page when listing all runs
+const runPageSize = 100
+
+// listAllRuns pages through every experiment run matching the request, since the
+// server only returns a handful of runs when no pagination is given
+func (c *experimentClient) listAllRuns(request models.ListExperimentRunRequest, fetch func(string, models.ListExperimentRunRequest, types.Credentials) (experiment.ExperimentRunsList, error)) ([]*models.ExperimentRun, error) {
+	var runs []*models.ExperimentRun
+	for page := 0; ; page++ {
+		request.Pagination = &models.Pagination{
+			Page:  page,
+			Limit: runPageSize,
+		}
+
+		response, err := fetch(c.credentials.ProjectID, request, c.credentials)
+		if err != nil {
+			return nil, err
+		}
+
+		details := response.ListExperimentRunDetails
+		runs = append(runs, details.ExperimentRuns...)
+		if len(details.ExperimentRuns) < runPageSize || len(runs) >= details.TotalNoOfExperimentRuns {
+			return runs, nil
+		}
+	}
+}
+
+// experimentPageSize is the number of experiments fetched per page when listing all experiments
+const experimentPageSize = 100
+
+// listAllExperiments pages through every experiment matching the request
+func (c *experimentClient) listAllExperiments(request models.ListExperimentRequest) ([]*models.Experiment, error) {
+	var experiments []*models.Experiment
+	for page := 0; ; page++ {
+		request.Pagination = &models.Pagination{
+			Page:  page,
+			Limit: experimentPageSize,
+		}
+
+		response, err := experiment.GetExperimentList(c.credentials.ProjectID, request, c.credentials)
+		if err != nil {
+			return nil, err
+		}
+
+		details := response.ListExperimentDetails
+		experiments = append(experiments, details.Experiments...)
+		if len(details.Experiments) < experimentPageSize || len(experiments) >= details.TotalNoOfExperiments {
+			return experiments, nil
+		}
+	}
+}
@@ -0,0 +1,270 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/experiment"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+// ExperimentSelector matches experiments for bulk operations.
+// All set fields must match; an empty selector matches every experiment.
+type ExperimentSelector struct {
+	// Tags that an experiment must all carry
+	Tags []string
+
+	// NamePattern is a regular expression matched against the experiment name
+	NamePattern string
+
+	// InfraID of the infrastructure the experiment targets
+	InfraID string
+
+	// ScheduleType selects cron or non-cron experiments
+	ScheduleType models.ScheduleType
+}
+
+// BulkOptions controls how a bulk operation is executed
+type BulkOptions struct {
+	// DryRun only resolves the matching experiments without changing them
+	DryRun bool
+
+	// Concurrency limits the number of parallel requests, defaults to 4
+	Concurrency int
+}
+
+// TagChange describes the tags added to and removed from experiments by BulkRetag
+type TagChange struct {
+	Add    []string
+	Remove []string
+}
+
+// BulkItemResult is the outcome of a bulk operation on a single experiment
+type BulkItemResult struct {
+	ExperimentID   string
+	ExperimentName string
+	// Output holds operation specific output, such as the notify ID of a started run
+	Output string
+	Err    error
+}
+
+// BulkResult contains the per-experiment outcome of a bulk operation
+type BulkResult struct {
+	Operation string
+	DryRun    bool
+	Items     []BulkItemResult
+}
+
+// Failed returns the items for which the operation failed
+func (r BulkResult) Failed() []BulkItemResult {
+	var failed []BulkItemResult
+	for _, item := range r.Items {
+		if item.Err != nil {
+			failed = append(failed, item)
+		}
+	}
+	return failed
+}
+
+// String renders the result as a plan, one experiment per line
+func (r BulkResult) String() string {
+	var sb strings.Builder
+	for _, item := range r.Items {
+		status := r.Operation
+		switch {
+		case r.DryRun:
+			status = "would " + r.Operation
+		case item.Err != nil:
+			status = fmt.Sprintf("failed: %v", item.Err)
+		}
+		fmt.Fprintf(&sb, "%s\t%s\t%s", status, item.ExperimentName, item.ExperimentID)
+		if item.Output != "" {
+			fmt.Fprintf(&sb, "\t%s", item.Output)
+		}
+		sb.WriteString("\n")
+	}
+	fmt.Fprintf(&sb, "%d experiment(s) matched, %d failed\n", len(r.Items), len(r.Failed()))
+	return sb.String()
+}
+
+// Select retrieves all experiments matching a selector
+func (c *experimentClient) Select(selector ExperimentSelector) ([]*models.Experiment, error) {
+	if c.credentials.Endpoint == "" {
+		return nil, fmt.Errorf("endpoint not set in credentials")
+	}
+
+	if c.credentials.ProjectID == "" {
+		return nil, fmt.Errorf("project ID not set in credentials")
+	}
+
+	var namePattern *regexp.Regexp
+	if selector.NamePattern != "" {
+		var err error
+		namePattern, err = regexp.Compile(selector.NamePattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid name pattern: %w", err)
+		}
+	}
+
+	experiments, err := c.listAllExperiments(models.ListExperimentRequest{Filter: selectorFilter(selector)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list experiments: %w", err)
+	}
+
+	return matchExperiments(experiments, namePattern, selector.Tags), nil
+}
+
+// selectorFilter returns the part of a selector which is filtered server side,
+// infra and schedule type, while name and tags are matched client side
+func selectorFilter(selector ExperimentSelector) *models.ExperimentFilterInput {
+	filter := &models.ExperimentFilterInput{}
+	if selector.InfraID != "" {
+		filter.InfraID = &selector.InfraID
+	}
+	if selector.ScheduleType != "" {
+		filter.ScheduleType = &selector.ScheduleType
+	}
+	return filter
+}
+
+// matchExperiments keeps the experiments which are not removed, match the
+// name pattern when one is given and carry all tags
+func matchExperiments(experiments []*models.Experiment, namePattern *regexp.Regexp, tags []string) []*models.Experiment {
+	var matched []*models.Experiment
+	for _, exp := range experiments {
+		if exp == nil || exp.IsRemoved {
+			continue
+		}
+		if namePattern != nil && !namePattern.MatchString(exp.Name) {
+			continue
+		}
+		if !hasAllTags(exp.Tags, tags) {
+			continue
+		}
+		matched = append(matched, exp)
+	}
+	return matched
+}
+
+// BulkDelete removes all experiments matching a selector
+func (c *experimentClient) BulkDelete(selector ExperimentSelector, options BulkOptions) (BulkResult, error) {
+	return c.bulk("delete", selector, options, func(exp *models.Experiment) (string, error) {
+		return "", c.Delete(exp.ExperimentID)
+	})
+}
+
+// BulkRun starts all experiments matching a selector
+func (c *experimentClient) BulkRun(selector ExperimentSelector, options BulkOptions) (BulkResult, error) {
+	return c.bulk("run", selector, options, func(exp *models.Experiment) (string, error) {
+		return c.Run(exp.ExperimentID)
+	})
+}
+
+// BulkRetag adds and removes tags on all experiments matching a selector
+func (c *experimentClient) BulkRetag(selector ExperimentSelector, change TagChange, options BulkOptions) (BulkResult, error) {
+	if len(change.Add) == 0 && len(change.Remove) == 0 {
+		return BulkResult{}, fmt.Errorf("no tags to add or remove")
+	}
+
+	return c.bulk("retag", selector, options, func(exp *models.Experiment) (string, error) {
+		tags := retag(exp.Tags, change)
+
+		request := models.SaveChaosExperimentRequest{
+			ID:          exp.ExperimentID,
+			Name:        exp.Name,
+			Description: exp.Description,
+			Manifest:    exp.ExperimentManifest,
+			Tags:        tags,
+		}
+		if exp.Infra != nil {
+			request.InfraID = exp.Infra.InfraID
+		}
+		if exp.ExperimentType != nil {
+			experimentType := models.ExperimentType(*exp.ExperimentType)
+			request.Type = &experimentType
+		}
+
+		if _, err := experiment.SaveExperiment(c.credentials.ProjectID, request, c.credentials); err != nil {
+			return "", fmt.Errorf("failed to update experiment: %w", err)
+		}
+		return strings.Join(tags, ","), nil
+	})
+}
+
+// bulk resolves the selector and applies fn to every matching experiment
+func (c *experimentClient) bulk(operation string, selector ExperimentSelector, options BulkOptions, fn func(*models.Experiment) (string, error)) (BulkResult, error) {
+	experiments, err := c.Select(selector)
+	if err != nil {
+		return BulkResult{}, err
+	}
+
+	result := BulkResult{
+		Operation: operation,
+		DryRun:    options.DryRun,
+		Items:     make([]BulkItemResult, len(experiments)),
+	}
+	for i, exp := range experiments {
+		result.Items[i] = BulkItemResult{
+			ExperimentID:   exp.ExperimentID,
+			ExperimentName: exp.Name,
+		}
+	}
+	if options.DryRun {
+		return result, nil
+	}
+
+	runBounded(len(experiments), options.Concurrency, func(i int) {
+		result.Items[i].Output, result.Items[i].Err = fn(experiments[i])
+	})
+
+	return result, nil
+}
+
+// hasAllTags reports whether every wanted tag is present
+func hasAllTags(tags []string, wanted []string) bool {
+	present := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		present[tag] = true
+	}
+	for _, tag := range wanted {
+		if !present[tag] {
+			return false
+		}
+	}
+	return true
+}
+
+// retag applies a tag change while keeping the original tag order
+func retag(tags []string, change TagChange) []string {
+	remove := make(map[string]bool, len(change.Remove))
+	for _, tag := range change.Remove {
+		remove[tag] = true
+	}
+
+	seen := make(map[string]bool)
+	result := []string{}
+	for _, tag := range append(append([]string{}, tags...), change.Add...) {
+		if remove[tag] || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		result = append(result, tag)
+	}
+	return result
+}
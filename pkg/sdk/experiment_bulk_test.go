@@ -0,0 +1,88 @@
+package sdk
+
+import (
+	"regexp"
+	"testing"
+
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetag(t *testing.T) {
+	tests := []struct {
+		name   string
+		tags   []string
+		change TagChange
+		want   []string
+	}{
+		{name: "add", tags: []string{"a"}, change: TagChange{Add: []string{"b"}}, want: []string{"a", "b"}},
+		{name: "remove", tags: []string{"a", "b", "c"}, change: TagChange{Remove: []string{"b"}}, want: []string{"a", "c"}},
+		{name: "add existing", tags: []string{"a", "b"}, change: TagChange{Add: []string{"a"}}, want: []string{"a", "b"}},
+		{name: "duplicates collapse", tags: []string{"a", "a"}, change: TagChange{Add: []string{"b", "b"}}, want: []string{"a", "b"}},
+		{name: "remove wins over add", tags: []string{"a"}, change: TagChange{Add: []string{"b"}, Remove: []string{"b"}}, want: []string{"a"}},
+		{name: "remove all", tags: []string{"a"}, change: TagChange{Remove: []string{"a"}}, want: []string{}},
+		{name: "no tags", change: TagChange{Add: []string{"a"}}, want: []string{"a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, retag(tt.tags, tt.change))
+		})
+	}
+}
+
+func TestHasAllTags(t *testing.T) {
+	tests := []struct {
+		tags   []string
+		wanted []string
+		want   bool
+	}{
+		{tags: []string{"a", "b"}, wanted: nil, want: true},
+		{tags: nil, wanted: nil, want: true},
+		{tags: []string{"a", "b"}, wanted: []string{"b", "a"}, want: true},
+		{tags: []string{"a"}, wanted: []string{"a", "b"}, want: false},
+		{tags: nil, wanted: []string{"a"}, want: false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, hasAllTags(tt.tags, tt.wanted), "tags %v, wanted %v", tt.tags, tt.wanted)
+	}
+}
+
+func TestSelectorFiltering(t *testing.T) {
+	experiments := []*models.Experiment{
+		{ExperimentID: "1", Name: "pod-delete", Tags: []string{"team-a", "nightly"}},
+		{ExperimentID: "2", Name: "pod-cpu-hog", Tags: []string{"team-a"}},
+		{ExperimentID: "3", Name: "node-drain", Tags: []string{"team-b", "nightly"}},
+		{ExperimentID: "4", Name: "pod-removed", Tags: []string{"team-a", "nightly"}, IsRemoved: true},
+		nil,
+	}
+
+	tests := []struct {
+		name        string
+		namePattern *regexp.Regexp
+		tags        []string
+		want        []string
+	}{
+		{name: "everything", want: []string{"1", "2", "3"}},
+		{name: "tags", tags: []string{"nightly"}, want: []string{"1", "3"}},
+		{name: "name", namePattern: regexp.MustCompile(`^pod-`), want: []string{"1", "2"}},
+		{name: "name and tags", namePattern: regexp.MustCompile(`^pod-`), tags: []string{"nightly"}, want: []string{"1"}},
+		{name: "no match", tags: []string{"team-c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ids []string
+			for _, exp := range matchExperiments(experiments, tt.namePattern, tt.tags) {
+				ids = append(ids, exp.ExperimentID)
+			}
+			assert.Equal(t, tt.want, ids)
+		})
+	}
+
+	filter := selectorFilter(ExperimentSelector{InfraID: "infra", ScheduleType: models.ScheduleTypeCron, Tags: []string{"a"}})
+	assert.Equal(t, "infra", *filter.InfraID)
+	assert.Equal(t, models.ScheduleTypeCron, *filter.ScheduleType)
+	assert.Equal(t, &models.ExperimentFilterInput{}, selectorFilter(ExperimentSelector{NamePattern: "x"}))
+}
@@ -0,0 +1,351 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/experiment"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/probe"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/bundle"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/manifest"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+// ConflictStrategy decides what Import does with an experiment whose name already exists in the target project
+type ConflictStrategy string
+
+const (
+	// ConflictSkip leaves the existing experiment untouched
+	ConflictSkip ConflictStrategy = "skip"
+	// ConflictOverwrite replaces the manifest and metadata of the existing experiment
+	ConflictOverwrite ConflictStrategy = "overwrite"
+	// ConflictRename imports the experiment under a new, unused name
+	ConflictRename ConflictStrategy = "rename"
+)
+
+// ImportOptions configures Import
+type ImportOptions struct {
+	// ProjectID is the target project, defaults to the project of the credentials
+	ProjectID string
+
+	// InfraMapping maps the infra ID or name of the source project to an infra ID
+	// of the target project. Experiments whose infra is not mapped keep their infra ID,
+	// which only works when importing into the project they were exported from.
+	InfraMapping map[string]string
+
+	// Conflict decides what happens to experiments which already exist, defaults to ConflictSkip
+	Conflict ConflictStrategy
+}
+
+// ImportAction is what Import did with a single experiment
+type ImportAction string
+
+const (
+	ImportCreated     ImportAction = "created"
+	ImportOverwritten ImportAction = "overwritten"
+	ImportRenamed     ImportAction = "renamed"
+	ImportSkipped     ImportAction = "skipped"
+	ImportFailed      ImportAction = "failed"
+)
+
+// ImportedExperiment is the result of importing a single experiment
+type ImportedExperiment struct {
+	// Name is the name of the experiment in the bundle
+	Name string
+	// TargetName and TargetID identify the experiment in the target project
+	TargetName string
+	TargetID   string
+	Action     ImportAction
+	Err        error
+}
+
+// ImportResult summarizes an import
+type ImportResult struct {
+	Experiments []ImportedExperiment
+	// ProbesCreated lists the bundled probes which did not exist in the target project
+	ProbesCreated []string
+	// ProbesExisting lists the bundled probes which were already present and left untouched
+	ProbesExisting []string
+}
+
+// Failed returns the experiments which could not be imported
+func (r ImportResult) Failed() []ImportedExperiment {
+	var failed []ImportedExperiment
+	for _, e := range r.Experiments {
+		if e.Err != nil {
+			failed = append(failed, e)
+		}
+	}
+	return failed
+}
+
+// String renders a one line per experiment summary of the import
+func (r ImportResult) String() string {
+	var b strings.Builder
+	for _, e := range r.Experiments {
+		switch {
+		case e.Err != nil:
+			fmt.Fprintf(&b, "%s: %s: %v\n", e.Name, e.Action, e.Err)
+		case e.TargetName != "" && e.TargetName != e.Name:
+			fmt.Fprintf(&b, "%s: %s as %s (%s)\n", e.Name, e.Action, e.TargetName, e.TargetID)
+		default:
+			fmt.Fprintf(&b, "%s: %s (%s)\n", e.Name, e.Action, e.TargetID)
+		}
+	}
+	fmt.Fprintf(&b, "probes: %d created, %d already present\n", len(r.ProbesCreated), len(r.ProbesExisting))
+	return b.String()
+}
+
+// Export builds a bundle from experiments of the project, including the
+// definitions of every probe their manifests reference
+func (c *experimentClient) Export(ids []string) (bundle.Bundle, error) {
+	if c.credentials.Endpoint == "" {
+		return bundle.Bundle{}, fmt.Errorf("endpoint not set in credentials")
+	}
+
+	if c.credentials.ProjectID == "" {
+		return bundle.Bundle{}, fmt.Errorf("project ID not set in credentials")
+	}
+
+	if len(ids) == 0 {
+		return bundle.Bundle{}, fmt.Errorf("at least one experiment ID is required")
+	}
+
+	b := bundle.Bundle{
+		Version:         bundle.Version,
+		ExportedAt:      time.Now().UTC(),
+		SourceProjectID: c.credentials.ProjectID,
+	}
+
+	probes := map[string]bool{}
+	for _, id := range ids {
+		if id == "" {
+			return bundle.Bundle{}, fmt.Errorf("experiment ID cannot be empty")
+		}
+
+		response, err := experiment.GetExperiment(c.credentials.ProjectID, id, c.credentials)
+		if err != nil {
+			return bundle.Bundle{}, fmt.Errorf("failed to get experiment %s: %w", id, err)
+		}
+		details := response.ExperimentDetails.ExperimentDetails
+		if details == nil {
+			return bundle.Bundle{}, fmt.Errorf("experiment not found with ID: %s", id)
+		}
+
+		exported := bundle.Experiment{
+			ID:          details.ExperimentID,
+			Name:        details.Name,
+			Description: details.Description,
+			Tags:        details.Tags,
+			CronSyntax:  details.CronSyntax,
+			Manifest:    details.ExperimentManifest,
+		}
+		if details.Infra != nil {
+			exported.InfraID = details.Infra.InfraID
+			exported.InfraName = details.Infra.Name
+		}
+		b.Experiments = append(b.Experiments, exported)
+
+		names, err := manifest.ProbeNames(details.ExperimentManifest)
+		if err != nil {
+			return bundle.Bundle{}, fmt.Errorf("failed to read probe references of experiment %s: %w", id, err)
+		}
+		for _, name := range names {
+			if probes[name] {
+				continue
+			}
+			probes[name] = true
+
+			probeResponse, err := probe.GetProbeRequest(c.credentials.ProjectID, name, c.credentials)
+			if err != nil {
+				return bundle.Bundle{}, fmt.Errorf("failed to get probe %s: %w", name, err)
+			}
+			b.Probes = append(b.Probes, probe.RequestFromModel(probeResponse.Data.GetProbe))
+		}
+	}
+
+	if err := b.Validate(); err != nil {
+		return bundle.Bundle{}, err
+	}
+	return b, nil
+}
+
+// Import recreates the experiments and probes of a bundle in a project. Probes
+// which already exist are left untouched. An error is returned when the import
+// cannot start, failures of single experiments are reported in the result.
+func (c *experimentClient) Import(b bundle.Bundle, options ImportOptions) (ImportResult, error) {
+	if c.credentials.Endpoint == "" {
+		return ImportResult{}, fmt.Errorf("endpoint not set in credentials")
+	}
+
+	target := &experimentClient{credentials: c.credentials}
+	if options.ProjectID != "" {
+		target.credentials.ProjectID = options.ProjectID
+	}
+	if target.credentials.ProjectID == "" {
+		return ImportResult{}, fmt.Errorf("project ID not set in credentials")
+	}
+
+	switch options.Conflict {
+	case "":
+		options.Conflict = ConflictSkip
+	case ConflictSkip, ConflictOverwrite, ConflictRename:
+	default:
+		return ImportResult{}, fmt.Errorf("invalid conflict strategy %q", options.Conflict)
+	}
+
+	if err := b.Validate(); err != nil {
+		return ImportResult{}, fmt.Errorf("invalid bundle: %w", err)
+	}
+
+	var result ImportResult
+	if err := target.importProbes(b.Probes, &result); err != nil {
+		return result, err
+	}
+
+	existing, err := target.listAllExperiments(models.ListExperimentRequest{})
+	if err != nil {
+		return result, fmt.Errorf("failed to list experiments: %w", err)
+	}
+	byName := make(map[string]string, len(existing))
+	for _, e := range existing {
+		byName[e.Name] = e.ExperimentID
+	}
+
+	for _, e := range b.Experiments {
+		imported := target.importExperiment(e, options, byName)
+		if imported.Err == nil {
+			byName[imported.TargetName] = imported.TargetID
+		}
+		result.Experiments = append(result.Experiments, imported)
+	}
+
+	return result, nil
+}
+
+// importProbes creates the bundled probes missing from the project
+func (c *experimentClient) importProbes(probes []probe.ProbeRequest, result *ImportResult) error {
+	if len(probes) == 0 {
+		return nil
+	}
+
+	response, err := probe.ListProbeRequest(c.credentials.ProjectID, nil, c.credentials)
+	if err != nil {
+		return fmt.Errorf("failed to list probes: %w", err)
+	}
+	existing := make(map[string]bool, len(response.Data.Probes))
+	for _, p := range response.Data.Probes {
+		existing[p.Name] = true
+	}
+
+	for _, p := range probes {
+		if existing[p.Name] {
+			result.ProbesExisting = append(result.ProbesExisting, p.Name)
+			continue
+		}
+		if _, err := probe.CreateProbeRequest(p, c.credentials.ProjectID, c.credentials); err != nil {
+			return fmt.Errorf("failed to create probe %s: %w", p.Name, err)
+		}
+		result.ProbesCreated = append(result.ProbesCreated, p.Name)
+	}
+	return nil
+}
+
+// importExperiment saves a single bundled experiment, resolving name conflicts
+// against byName which maps the experiment names of the project to their IDs
+func (c *experimentClient) importExperiment(e bundle.Experiment, options ImportOptions, byName map[string]string) ImportedExperiment {
+	imported := ImportedExperiment{Name: e.Name, TargetName: e.Name, Action: ImportCreated}
+
+	request := models.SaveChaosExperimentRequest{
+		ID:          uuid.New().String(),
+		Name:        e.Name,
+		Description: e.Description,
+		Tags:        e.Tags,
+		InfraID:     mapInfra(e, options.InfraMapping),
+	}
+
+	if id, ok := byName[e.Name]; ok {
+		switch options.Conflict {
+		case ConflictSkip:
+			imported.TargetID = id
+			imported.Action = ImportSkipped
+			return imported
+		case ConflictOverwrite:
+			request.ID = id
+			imported.Action = ImportOverwritten
+		case ConflictRename:
+			request.Name = uniqueName(e.Name, byName)
+			imported.Action = ImportRenamed
+		}
+	}
+	imported.TargetName = request.Name
+	imported.TargetID = request.ID
+
+	fail := func(err error) ImportedExperiment {
+		imported.Action = ImportFailed
+		imported.Err = err
+		return imported
+	}
+
+	if request.InfraID == "" {
+		return fail(fmt.Errorf("experiment has no infra, add it to the infra mapping"))
+	}
+	if kind, err := manifest.Kind(e.Manifest); err == nil && kind == manifest.KindCronWorkflow {
+		experimentType := models.ExperimentTypeCronExperiment
+		request.Type = &experimentType
+	}
+
+	var err error
+	request.Manifest, err = manifest.Retarget(e.Manifest, manifest.RetargetOptions{
+		Name:         request.Name,
+		ExperimentID: request.ID,
+		InfraID:      request.InfraID,
+	})
+	if err != nil {
+		return fail(fmt.Errorf("failed to rewrite manifest: %w", err))
+	}
+
+	if _, err := experiment.SaveExperiment(c.credentials.ProjectID, request, c.credentials); err != nil {
+		return fail(fmt.Errorf("failed to save experiment: %w", err))
+	}
+	return imported
+}
+
+// mapInfra resolves the infra of an experiment in the target project
+func mapInfra(e bundle.Experiment, mapping map[string]string) string {
+	if id, ok := mapping[e.InfraID]; ok && e.InfraID != "" {
+		return id
+	}
+	if id, ok := mapping[e.InfraName]; ok && e.InfraName != "" {
+		return id
+	}
+	return e.InfraID
+}
+
+// uniqueName appends an "-imported" suffix to name until it is unused
+func uniqueName(name string, taken map[string]string) string {
+	candidate := name + "-imported"
+	for i := 2; ; i++ {
+		if _, ok := taken[candidate]; !ok {
+			return candidate
+		}
+		candidate = fmt.Sprintf("%s-imported-%d", name, i)
+	}
+}
@@ -0,0 +1,53 @@
+package sdk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/bundle"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapInfra(t *testing.T) {
+	mapping := map[string]string{"infra-1": "target-1", "staging": "target-2"}
+
+	tests := []struct {
+		name       string
+		experiment bundle.Experiment
+		want       string
+	}{
+		{name: "by id", experiment: bundle.Experiment{InfraID: "infra-1", InfraName: "staging"}, want: "target-1"},
+		{name: "by name", experiment: bundle.Experiment{InfraID: "infra-9", InfraName: "staging"}, want: "target-2"},
+		{name: "unmapped", experiment: bundle.Experiment{InfraID: "infra-9", InfraName: "prod"}, want: "infra-9"},
+		{name: "no infra", experiment: bundle.Experiment{}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, mapInfra(tt.experiment, mapping))
+		})
+	}
+}
+
+func TestUniqueName(t *testing.T) {
+	taken := map[string]string{"pod-delete": "1"}
+	assert.Equal(t, "pod-delete-imported", uniqueName("pod-delete", taken))
+
+	taken["pod-delete-imported"] = "2"
+	taken["pod-delete-imported-2"] = "3"
+	assert.Equal(t, "pod-delete-imported-3", uniqueName("pod-delete", taken))
+}
+
+func TestImportResult(t *testing.T) {
+	result := ImportResult{
+		Experiments: []ImportedExperiment{
+			{Name: "a", TargetName: "a", TargetID: "1", Action: ImportCreated},
+			{Name: "b", TargetName: "b-imported", TargetID: "2", Action: ImportRenamed},
+			{Name: "c", TargetName: "c", Action: ImportFailed, Err: errors.New("boom")},
+		},
+		ProbesCreated: []string{"p1"},
+	}
+
+	assert.Len(t, result.Failed(), 1)
+	assert.Equal(t, "a: created (1)\nb: renamed as b-imported (2)\nc: failed: boom\nprobes: 1 created, 0 already present\n", result.String())
+}
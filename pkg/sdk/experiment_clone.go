@@ -0,0 +1,177 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/experiment"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/probe"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/manifest"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+// CloneOptions describes the copy made by Clone. Empty fields keep the value of the source experiment.
+type CloneOptions struct {
+	// Name of the copy, defaults to the source name with a "-copy" suffix
+	Name string
+
+	// ID of the copy, a new one is generated when empty
+	ID string
+
+	// Description of the copy
+	Description string
+
+	// Tags of the copy
+	Tags []string
+
+	// ProjectID is the destination project, the credentials must have access to it
+	ProjectID string
+
+	// InfraID is the chaos infrastructure the copy runs on. It is required when
+	// cloning into another project, since infrastructures belong to a project.
+	InfraID string
+
+	// Namespace replaces the namespace of the workflow
+	Namespace string
+
+	// AppNamespace replaces the application namespace targeted by every fault
+	AppNamespace string
+
+	// Parameters sets workflow arguments such as adminModeNamespace
+	Parameters map[string]string
+}
+
+// Clone copies an experiment, optionally into another project and onto another
+// chaos infrastructure. The probes referenced by the manifest must exist in the
+// destination project. The saved request of the copy is returned.
+func (c *experimentClient) Clone(id string, options CloneOptions) (models.SaveChaosExperimentRequest, error) {
+	if c.credentials.Endpoint == "" {
+		return models.SaveChaosExperimentRequest{}, fmt.Errorf("endpoint not set in credentials")
+	}
+
+	if c.credentials.ProjectID == "" {
+		return models.SaveChaosExperimentRequest{}, fmt.Errorf("project ID not set in credentials")
+	}
+
+	if id == "" {
+		return models.SaveChaosExperimentRequest{}, fmt.Errorf("experiment ID cannot be empty")
+	}
+
+	projectID := options.ProjectID
+	if projectID == "" {
+		projectID = c.credentials.ProjectID
+	}
+
+	if projectID != c.credentials.ProjectID && options.InfraID == "" {
+		return models.SaveChaosExperimentRequest{}, fmt.Errorf("an infra ID of project %s must be given to clone into it", projectID)
+	}
+
+	response, err := experiment.GetExperiment(c.credentials.ProjectID, id, c.credentials)
+	if err != nil {
+		return models.SaveChaosExperimentRequest{}, fmt.Errorf("failed to get experiment: %w", err)
+	}
+	source := response.ExperimentDetails.ExperimentDetails
+	if source == nil {
+		return models.SaveChaosExperimentRequest{}, fmt.Errorf("experiment not found with ID: %s", id)
+	}
+
+	request := models.SaveChaosExperimentRequest{
+		ID:          options.ID,
+		Name:        options.Name,
+		Description: options.Description,
+		Tags:        options.Tags,
+		InfraID:     options.InfraID,
+	}
+	if request.ID == "" {
+		request.ID = uuid.New().String()
+	}
+	if request.Name == "" {
+		request.Name = source.Name + "-copy"
+	}
+	if request.Description == "" {
+		request.Description = source.Description
+	}
+	if request.Tags == nil {
+		request.Tags = source.Tags
+	}
+	if request.InfraID == "" && source.Infra != nil {
+		request.InfraID = source.Infra.InfraID
+	}
+	if request.InfraID == "" {
+		return models.SaveChaosExperimentRequest{}, fmt.Errorf("experiment %s has no infra, an infra ID must be given", id)
+	}
+	if kind, err := manifest.Kind(source.ExperimentManifest); err == nil && kind == manifest.KindCronWorkflow {
+		experimentType := models.ExperimentTypeCronExperiment
+		request.Type = &experimentType
+	}
+
+	request.Manifest, err = manifest.Retarget(source.ExperimentManifest, manifest.RetargetOptions{
+		Name:         request.Name,
+		ExperimentID: request.ID,
+		InfraID:      request.InfraID,
+		Namespace:    options.Namespace,
+		AppNamespace: options.AppNamespace,
+		Parameters:   options.Parameters,
+	})
+	if err != nil {
+		return models.SaveChaosExperimentRequest{}, fmt.Errorf("failed to rewrite manifest: %w", err)
+	}
+
+	if err := c.checkProbesExist(projectID, request.Manifest); err != nil {
+		return models.SaveChaosExperimentRequest{}, err
+	}
+
+	if _, err := experiment.SaveExperiment(projectID, request, c.credentials); err != nil {
+		return models.SaveChaosExperimentRequest{}, fmt.Errorf("failed to save experiment copy: %w", err)
+	}
+
+	return request, nil
+}
+
+// checkProbesExist verifies that every probe referenced by a manifest exists in a project
+func (c *experimentClient) checkProbesExist(projectID string, experimentManifest string) error {
+	names, err := manifest.ProbeNames(experimentManifest)
+	if err != nil {
+		return fmt.Errorf("failed to read probe references: %w", err)
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	response, err := probe.ListProbeRequest(projectID, nil, c.credentials)
+	if err != nil {
+		return fmt.Errorf("failed to list probes: %w", err)
+	}
+
+	existing := make(map[string]bool, len(response.Data.Probes))
+	for _, p := range response.Data.Probes {
+		existing[p.Name] = true
+	}
+
+	var missing []string
+	for _, name := range names {
+		if !existing[name] {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("probes referenced by the experiment do not exist in project %s: %s", projectID, strings.Join(missing, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloneIntoAnotherProjectRequiresInfra(t *testing.T) {
+	c := &experimentClient{credentials: types.Credentials{Endpoint: "http://unused", ProjectID: "source"}}
+
+	_, err := c.Clone("experiment", CloneOptions{ProjectID: "destination"})
+	assert.EqualError(t, err, "an infra ID of project destination must be given to clone into it")
+}
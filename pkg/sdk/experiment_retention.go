@@ -0,0 +1,244 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/experiment"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+// defaultConcurrency is used by bulk operations when no concurrency limit is given
+const defaultConcurrency = 4
+
+// PruneRunsOptions describes which experiment runs PruneRuns removes.
+// A run is pruned when it is older than OlderThan or when it is not among the
+// KeepLast most recent runs of its experiment. Runs which are still in progress
+// are never pruned.
+type PruneRunsOptions struct {
+	// ExperimentIDs limits pruning to the given experiments, all experiments of the project are considered when empty
+	ExperimentIDs []string
+
+	// OlderThan prunes runs created longer ago than this duration, zero disables the age limit
+	OlderThan time.Duration
+
+	// KeepLast keeps this many of the most recent runs per experiment, zero disables the count limit
+	KeepLast int
+
+	// DryRun only computes the runs which would be pruned without deleting them
+	DryRun bool
+
+	// Concurrency limits the number of parallel delete requests, defaults to 4
+	Concurrency int
+}
+
+// PrunedRun describes a single run selected by PruneRuns
+type PrunedRun struct {
+	ExperimentID    string
+	ExperimentName  string
+	ExperimentRunID string
+	Phase           string
+	CreatedAt       time.Time
+	Reason          string
+	Deleted         bool
+	Err             error
+}
+
+// PruneRunsResult contains the outcome of PruneRuns
+type PruneRunsResult struct {
+	DryRun bool
+	Runs   []PrunedRun
+}
+
+// Deleted returns the number of runs which were removed
+func (r PruneRunsResult) Deleted() int {
+	deleted := 0
+	for _, run := range r.Runs {
+		if run.Deleted {
+			deleted++
+		}
+	}
+	return deleted
+}
+
+// Failed returns the runs which could not be removed
+func (r PruneRunsResult) Failed() []PrunedRun {
+	var failed []PrunedRun
+	for _, run := range r.Runs {
+		if run.Err != nil {
+			failed = append(failed, run)
+		}
+	}
+	return failed
+}
+
+// String renders the result as a plan, one run per line
+func (r PruneRunsResult) String() string {
+	var sb strings.Builder
+	action := "deleted"
+	if r.DryRun {
+		action = "would delete"
+	}
+
+	for _, run := range r.Runs {
+		status := action
+		if run.Err != nil {
+			status = fmt.Sprintf("failed: %v", run.Err)
+		}
+		fmt.Fprintf(&sb, "%s\t%s/%s\t%s\t%s\t%s\n", status, run.ExperimentName, run.ExperimentRunID, run.Phase, run.CreatedAt.Format(time.RFC3339), run.Reason)
+	}
+
+	if r.DryRun {
+		fmt.Fprintf(&sb, "%d run(s) would be pruned\n", len(r.Runs))
+	} else {
+		fmt.Fprintf(&sb, "%d of %d run(s) pruned\n", r.Deleted(), len(r.Runs))
+	}
+	return sb.String()
+}
+
+// PruneRuns removes experiment runs according to a retention policy
+func (c *experimentClient) PruneRuns(options PruneRunsOptions) (PruneRunsResult, error) {
+	if c.credentials.Endpoint == "" {
+		return PruneRunsResult{}, fmt.Errorf("endpoint not set in credentials")
+	}
+
+	if c.credentials.ProjectID == "" {
+		return PruneRunsResult{}, fmt.Errorf("project ID not set in credentials")
+	}
+
+	if options.OlderThan <= 0 && options.KeepLast <= 0 {
+		return PruneRunsResult{}, fmt.Errorf("either OlderThan or KeepLast must be set")
+	}
+
+	request := models.ListExperimentRunRequest{}
+	for i := range options.ExperimentIDs {
+		request.ExperimentIDs = append(request.ExperimentIDs, &options.ExperimentIDs[i])
+	}
+
+	runs, err := c.listAllRuns(request, experiment.GetExperimentRunsList)
+	if err != nil {
+		return PruneRunsResult{}, fmt.Errorf("failed to list experiment runs: %w", err)
+	}
+
+	result := PruneRunsResult{
+		DryRun: options.DryRun,
+		Runs:   selectRunsToPrune(runs, options, time.Now()),
+	}
+	if options.DryRun {
+		return result, nil
+	}
+
+	runBounded(len(result.Runs), options.Concurrency, func(i int) {
+		run := &result.Runs[i]
+		if err := c.DeleteRun(run.ExperimentID, run.ExperimentRunID); err != nil {
+			run.Err = err
+			return
+		}
+		run.Deleted = true
+	})
+
+	return result, nil
+}
+
+// selectRunsToPrune applies the retention policy to the given runs
+func selectRunsToPrune(runs []*models.ExperimentRun, options PruneRunsOptions, now time.Time) []PrunedRun {
+	byExperiment := make(map[string][]*models.ExperimentRun)
+	var experimentIDs []string
+	for _, run := range runs {
+		if run == nil {
+			continue
+		}
+		if _, ok := byExperiment[run.ExperimentID]; !ok {
+			experimentIDs = append(experimentIDs, run.ExperimentID)
+		}
+		byExperiment[run.ExperimentID] = append(byExperiment[run.ExperimentID], run)
+	}
+	sort.Strings(experimentIDs)
+
+	var selected []PrunedRun
+	for _, experimentID := range experimentIDs {
+		experimentRuns := byExperiment[experimentID]
+
+		// Newest first, so that the index is the position in the retention window
+		sort.SliceStable(experimentRuns, func(i, j int) bool {
+			return timestampBefore(experimentRuns[j].CreatedAt, experimentRuns[i].CreatedAt)
+		})
+
+		for i, run := range experimentRuns {
+			if run.Phase == models.ExperimentRunStatusRunning || run.Phase == models.ExperimentRunStatusQueued {
+				continue
+			}
+
+			createdAt := parseTimestamp(run.CreatedAt)
+
+			var reason string
+			switch {
+			case options.KeepLast > 0 && i >= options.KeepLast:
+				reason = fmt.Sprintf("beyond last %d runs", options.KeepLast)
+			case options.OlderThan > 0 && !createdAt.IsZero() && now.Sub(createdAt) > options.OlderThan:
+				reason = fmt.Sprintf("older than %s", options.OlderThan)
+			default:
+				continue
+			}
+
+			selected = append(selected, PrunedRun{
+				ExperimentID:    run.ExperimentID,
+				ExperimentName:  run.ExperimentName,
+				ExperimentRunID: run.ExperimentRunID,
+				Phase:           string(run.Phase),
+				CreatedAt:       createdAt,
+				Reason:          reason,
+			})
+		}
+	}
+
+	return selected
+}
+
+// runBounded calls fn for every index in [0, n) with at most concurrency calls in flight
+func runBounded(n int, concurrency int, fn func(i int)) {
+	if concurrency <= 0 {
+		concurrency = defaultConcurrency
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			fn(i)
+		}(i)
+	}
+	wg.Wait()
+}
+
+// parseTimestamp converts a ChaosCenter timestamp into a time, returning the zero time if it cannot be parsed
+func parseTimestamp(ts string) time.Time {
+	ms, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms)
+}
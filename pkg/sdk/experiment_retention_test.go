@@ -0,0 +1,104 @@
+package sdk
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectRunsToPrune(t *testing.T) {
+	now := time.UnixMilli(100 * int64(time.Hour/time.Millisecond))
+	hoursAgo := func(h int) string {
+		return strconv.FormatInt(now.Add(-time.Duration(h)*time.Hour).UnixMilli(), 10)
+	}
+	run := func(experimentID, runID string, phase models.ExperimentRunStatus, createdAt string) *models.ExperimentRun {
+		return &models.ExperimentRun{ExperimentID: experimentID, ExperimentRunID: runID, Phase: phase, CreatedAt: createdAt}
+	}
+
+	runs := []*models.ExperimentRun{
+		run("b", "b1", models.ExperimentRunStatusCompleted, hoursAgo(1)),
+		run("a", "a3", models.ExperimentRunStatusCompleted, hoursAgo(1)),
+		run("a", "a1", models.ExperimentRunStatusError, hoursAgo(48)),
+		run("a", "a2", models.ExperimentRunStatusRunning, hoursAgo(24)),
+		run("a", "a0", models.ExperimentRunStatusQueued, hoursAgo(72)),
+		run("a", "a4", models.ExperimentRunStatusStopped, hoursAgo(96)),
+		nil,
+	}
+
+	tests := []struct {
+		name    string
+		options PruneRunsOptions
+		want    []string
+		reasons []string
+	}{
+		{
+			name:    "keep last",
+			options: PruneRunsOptions{KeepLast: 2},
+			want:    []string{"a1", "a4"},
+			reasons: []string{"beyond last 2 runs", "beyond last 2 runs"},
+		},
+		{
+			name:    "older than",
+			options: PruneRunsOptions{OlderThan: 12 * time.Hour},
+			want:    []string{"a1", "a4"},
+			reasons: []string{"older than 12h0m0s", "older than 12h0m0s"},
+		},
+		{
+			name:    "both limits",
+			options: PruneRunsOptions{KeepLast: 3, OlderThan: 36 * time.Hour},
+			want:    []string{"a1", "a4"},
+			reasons: []string{"older than 36h0m0s", "beyond last 3 runs"},
+		},
+		{
+			name:    "nothing to prune",
+			options: PruneRunsOptions{KeepLast: 10, OlderThan: 100 * time.Hour},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selected := selectRunsToPrune(runs, tt.options, now)
+
+			var ids, reasons []string
+			for _, s := range selected {
+				ids = append(ids, s.ExperimentRunID)
+				reasons = append(reasons, s.Reason)
+				assert.NotEqual(t, string(models.ExperimentRunStatusRunning), s.Phase, "in-progress runs are never pruned")
+				assert.NotEqual(t, string(models.ExperimentRunStatusQueued), s.Phase, "in-progress runs are never pruned")
+			}
+			assert.Equal(t, tt.want, ids)
+			assert.Equal(t, tt.reasons, reasons)
+		})
+	}
+}
+
+func TestRunBounded(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, peak := 0, 0
+	done := make([]bool, 20)
+
+	runBounded(len(done), 3, func(i int) {
+		mu.Lock()
+		inFlight++
+		if inFlight > peak {
+			peak = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		done[i] = true
+		mu.Unlock()
+	})
+
+	assert.LessOrEqual(t, peak, 3)
+	for i, d := range done {
+		assert.True(t, d, "index %d was not run", i)
+	}
+}
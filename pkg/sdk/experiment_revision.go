@@ -0,0 +1,221 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/experiment"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/manifest"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+// ListRevisions retrieves the manifest revisions of an experiment, oldest first.
+// ChaosCenter does not expose its revision records over the API, so revisions are
+// reconstructed from the manifest recorded on each run plus the current manifest.
+// Revision IDs are content fingerprints, suffixed when a manifest recurs, and
+// stay stable between calls.
+func (c *experimentClient) ListRevisions(id string) ([]experiment.ExperimentRevision, error) {
+	if c.credentials.Endpoint == "" {
+		return nil, fmt.Errorf("endpoint not set in credentials")
+	}
+
+	if c.credentials.ProjectID == "" {
+		return nil, fmt.Errorf("project ID not set in credentials")
+	}
+
+	if id == "" {
+		return nil, fmt.Errorf("experiment ID cannot be empty")
+	}
+
+	current, err := experiment.GetExperiment(c.credentials.ProjectID, id, c.credentials)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get experiment: %w", err)
+	}
+	if current.ExperimentDetails.ExperimentDetails == nil {
+		return nil, fmt.Errorf("experiment not found with ID: %s", id)
+	}
+
+	experimentRuns, err := c.listAllRuns(models.ListExperimentRunRequest{
+		ExperimentIDs: []*string{&id},
+	}, experiment.GetExperimentRunManifests)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list experiment runs: %w", err)
+	}
+
+	sort.SliceStable(experimentRuns, func(i, j int) bool {
+		return timestampBefore(experimentRuns[i].CreatedAt, experimentRuns[j].CreatedAt)
+	})
+
+	return buildRevisions(id, experimentRuns, current.ExperimentDetails.ExperimentDetails)
+}
+
+// buildRevisions groups runs sorted oldest first into revisions, merging
+// consecutive runs with the same manifest, and appends the current manifest.
+// A manifest which comes back after a change, such as A, B, A, starts a new
+// revision whose ID is the fingerprint suffixed with its occurrence, so that
+// every revision ID of an experiment is unique.
+func buildRevisions(id string, runs []*models.ExperimentRun, current *models.Experiment) ([]experiment.ExperimentRevision, error) {
+	var revisions []experiment.ExperimentRevision
+	var last string
+	occurrences := make(map[string]int)
+	add := func(fingerprint string, revision experiment.ExperimentRevision) {
+		occurrences[fingerprint]++
+		revision.RevisionID = fingerprint
+		if n := occurrences[fingerprint]; n > 1 {
+			revision.RevisionID = fmt.Sprintf("%s-%d", fingerprint, n)
+		}
+		revisions = append(revisions, revision)
+		last = fingerprint
+	}
+
+	for _, run := range runs {
+		if run == nil || run.ExperimentManifest == "" {
+			continue
+		}
+
+		fingerprint, err := manifest.Fingerprint(run.ExperimentManifest)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fingerprint manifest of run %s: %w", run.ExperimentRunID, err)
+		}
+
+		if len(revisions) > 0 && last == fingerprint {
+			n := len(revisions) - 1
+			revisions[n].ExperimentRunIDs = append(revisions[n].ExperimentRunIDs, run.ExperimentRunID)
+			continue
+		}
+
+		revision := experiment.ExperimentRevision{
+			ExperimentID:       id,
+			ExperimentManifest: run.ExperimentManifest,
+			UpdatedAt:          run.CreatedAt,
+			ExperimentRunIDs:   []string{run.ExperimentRunID},
+		}
+		if run.UpdatedBy != nil {
+			revision.UpdatedBy = run.UpdatedBy.Username
+		}
+		add(fingerprint, revision)
+	}
+
+	fingerprint, err := manifest.Fingerprint(current.ExperimentManifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fingerprint current manifest: %w", err)
+	}
+
+	if len(revisions) > 0 && last == fingerprint {
+		revisions[len(revisions)-1].IsCurrent = true
+	} else {
+		revision := experiment.ExperimentRevision{
+			ExperimentID:       id,
+			ExperimentManifest: current.ExperimentManifest,
+			UpdatedAt:          current.UpdatedAt,
+			IsCurrent:          true,
+		}
+		if current.UpdatedBy != nil {
+			revision.UpdatedBy = current.UpdatedBy.Username
+		}
+		add(fingerprint, revision)
+	}
+
+	return revisions, nil
+}
+
+// GetRevision retrieves a specific manifest revision of an experiment
+func (c *experimentClient) GetRevision(id string, revisionID string) (experiment.ExperimentRevision, error) {
+	if revisionID == "" {
+		return experiment.ExperimentRevision{}, fmt.Errorf("revision ID cannot be empty")
+	}
+
+	revisions, err := c.ListRevisions(id)
+	if err != nil {
+		return experiment.ExperimentRevision{}, err
+	}
+
+	for _, revision := range revisions {
+		if revision.RevisionID == revisionID {
+			return revision, nil
+		}
+	}
+
+	return experiment.ExperimentRevision{}, fmt.Errorf("revision %s not found for experiment %s", revisionID, id)
+}
+
+// DiffRevisions computes the structural difference between two revisions of an experiment
+func (c *experimentClient) DiffRevisions(id string, fromRevisionID string, toRevisionID string) (manifest.Diff, error) {
+	if fromRevisionID == "" || toRevisionID == "" {
+		return manifest.Diff{}, fmt.Errorf("revision IDs cannot be empty")
+	}
+
+	revisions, err := c.ListRevisions(id)
+	if err != nil {
+		return manifest.Diff{}, err
+	}
+
+	var from, to *experiment.ExperimentRevision
+	for i := range revisions {
+		if revisions[i].RevisionID == fromRevisionID {
+			from = &revisions[i]
+		}
+		if revisions[i].RevisionID == toRevisionID {
+			to = &revisions[i]
+		}
+	}
+	if from == nil {
+		return manifest.Diff{}, fmt.Errorf("revision %s not found for experiment %s", fromRevisionID, id)
+	}
+	if to == nil {
+		return manifest.Diff{}, fmt.Errorf("revision %s not found for experiment %s", toRevisionID, id)
+	}
+
+	return manifest.Compare(from.ExperimentManifest, to.ExperimentManifest)
+}
+
+// DiffManifest computes the structural difference between the server copy of an experiment and a local manifest
+func (c *experimentClient) DiffManifest(id string, localManifest string) (manifest.Diff, error) {
+	if c.credentials.Endpoint == "" {
+		return manifest.Diff{}, fmt.Errorf("endpoint not set in credentials")
+	}
+
+	if c.credentials.ProjectID == "" {
+		return manifest.Diff{}, fmt.Errorf("project ID not set in credentials")
+	}
+
+	if id == "" {
+		return manifest.Diff{}, fmt.Errorf("experiment ID cannot be empty")
+	}
+
+	current, err := experiment.GetExperiment(c.credentials.ProjectID, id, c.credentials)
+	if err != nil {
+		return manifest.Diff{}, fmt.Errorf("failed to get experiment: %w", err)
+	}
+	if current.ExperimentDetails.ExperimentDetails == nil {
+		return manifest.Diff{}, fmt.Errorf("experiment not found with ID: %s", id)
+	}
+
+	return manifest.Compare(current.ExperimentDetails.ExperimentDetails.ExperimentManifest, localManifest)
+}
+
+// timestampBefore compares ChaosCenter timestamps, which are unix milliseconds encoded as strings
+func timestampBefore(a, b string) bool {
+	ai, errA := strconv.ParseInt(a, 10, 64)
+	bi, errB := strconv.ParseInt(b, 10, 64)
+	if errA != nil || errB != nil {
+		return a < b
+	}
+	return ai < bi
+}
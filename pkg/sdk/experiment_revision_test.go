@@ -0,0 +1,56 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/experiment"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func revisionRun(id string, createdAt string, manifest string) *models.ExperimentRun {
+	return &models.ExperimentRun{ExperimentRunID: id, CreatedAt: createdAt, ExperimentManifest: manifest}
+}
+
+func revisionIDs(revisions []experiment.ExperimentRevision) []string {
+	var ids []string
+	for _, revision := range revisions {
+		ids = append(ids, revision.RevisionID)
+	}
+	return ids
+}
+
+func TestBuildRevisions(t *testing.T) {
+	a, b := `{"metadata":{"name":"a"}}`, `{"metadata":{"name":"b"}}`
+
+	revisions, err := buildRevisions("exp", []*models.ExperimentRun{
+		revisionRun("1", "1000", a),
+		revisionRun("2", "2000", a),
+		revisionRun("3", "3000", b),
+		revisionRun("4", "4000", ""),
+		revisionRun("5", "5000", a),
+	}, &models.Experiment{ExperimentManifest: a, UpdatedAt: "6000"})
+	require.NoError(t, err)
+	require.Len(t, revisions, 3)
+
+	ids := revisionIDs(revisions)
+	assert.Equal(t, ids[0]+"-2", ids[2], "a recurring manifest gets a distinct ID")
+	assert.NotEqual(t, ids[0], ids[1])
+	assert.Equal(t, []string{"1", "2"}, revisions[0].ExperimentRunIDs)
+	assert.Equal(t, []string{"3"}, revisions[1].ExperimentRunIDs)
+	assert.Equal(t, []string{"5"}, revisions[2].ExperimentRunIDs)
+	assert.False(t, revisions[0].IsCurrent)
+	assert.True(t, revisions[2].IsCurrent, "the current manifest is merged into the last run")
+
+	// IDs stay stable as the history grows
+	more, err := buildRevisions("exp", []*models.ExperimentRun{
+		revisionRun("1", "1000", a),
+		revisionRun("3", "3000", b),
+		revisionRun("5", "5000", a),
+	}, &models.Experiment{ExperimentManifest: b, UpdatedAt: "7000"})
+	require.NoError(t, err)
+	assert.Equal(t, append(ids, ids[1]+"-2"), revisionIDs(more))
+	assert.Empty(t, more[3].ExperimentRunIDs)
+	assert.Equal(t, "7000", more[3].UpdatedAt)
+}
@@ -0,0 +1,168 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/experiment"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+// defaultPollInterval is the interval between status checks while waiting for a run
+const defaultPollInterval = 10 * time.Second
+
+// WaitOptions configures how WaitForRun polls for the outcome of a run
+type WaitOptions struct {
+	// PollInterval is the interval between status checks, defaults to 10 seconds
+	PollInterval time.Duration
+
+	// OnUpdate is called with the run every time it is fetched
+	OnUpdate func(models.ExperimentRun)
+
+	// Throttle, if set, is called before every status check, for example to share
+	// a request rate between concurrent waits. Waiting is abandoned when it returns an error.
+	Throttle func(context.Context) error
+}
+
+// IsTerminalPhase reports whether a run in the given phase has finished
+func IsTerminalPhase(phase models.ExperimentRunStatus) bool {
+	switch phase {
+	case models.ExperimentRunStatusCompleted,
+		models.ExperimentRunStatusCompletedWithError,
+		models.ExperimentRunStatusStopped,
+		models.ExperimentRunStatusSkipped,
+		models.ExperimentRunStatusError,
+		models.ExperimentRunStatusTimeout,
+		models.ExperimentRunStatusTerminated:
+		return true
+	}
+	return false
+}
+
+// GetRunByNotifyID retrieves an experiment run, including its execution data, by the notify ID returned by Run
+func (c *experimentClient) GetRunByNotifyID(notifyID string) (models.ExperimentRun, error) {
+	if c.credentials.Endpoint == "" {
+		return models.ExperimentRun{}, fmt.Errorf("endpoint not set in credentials")
+	}
+
+	if c.credentials.ProjectID == "" {
+		return models.ExperimentRun{}, fmt.Errorf("project ID not set in credentials")
+	}
+
+	if notifyID == "" {
+		return models.ExperimentRun{}, fmt.Errorf("notify ID cannot be empty")
+	}
+
+	response, err := experiment.GetExperimentRunByNotifyID(c.credentials.ProjectID, notifyID, c.credentials)
+	if err != nil {
+		return models.ExperimentRun{}, fmt.Errorf("failed to get experiment run: %w", err)
+	}
+
+	return response.ExperimentRun, nil
+}
+
+// WaitForRun polls the run started with the given notify ID until it reaches a terminal phase.
+// The run is only recorded once the infrastructure picks it up, so lookup errors are retried
+// until the context is done.
+func (c *experimentClient) WaitForRun(ctx context.Context, notifyID string, options WaitOptions) (models.ExperimentRun, error) {
+	if notifyID == "" {
+		return models.ExperimentRun{}, fmt.Errorf("notify ID cannot be empty")
+	}
+
+	if options.PollInterval <= 0 {
+		options.PollInterval = defaultPollInterval
+	}
+
+	ticker := time.NewTicker(options.PollInterval)
+	defer ticker.Stop()
+
+	var run models.ExperimentRun
+	var lastErr error
+	abandoned := func(cause error) (models.ExperimentRun, error) {
+		if lastErr != nil {
+			return models.ExperimentRun{}, fmt.Errorf("%w while waiting for run %s: %v", cause, notifyID, lastErr)
+		}
+		return run, fmt.Errorf("%w while waiting for run %s in phase %s", cause, notifyID, run.Phase)
+	}
+
+	for {
+		if options.Throttle != nil {
+			if err := options.Throttle(ctx); err != nil {
+				return abandoned(err)
+			}
+		}
+
+		current, err := c.GetRunByNotifyID(notifyID)
+		if err == nil {
+			run, lastErr = current, nil
+			if options.OnUpdate != nil {
+				options.OnUpdate(run)
+			}
+			if IsTerminalPhase(run.Phase) {
+				return run, nil
+			}
+		} else {
+			lastErr = err
+		}
+
+		select {
+		case <-ctx.Done():
+			return abandoned(ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop stops all ongoing runs of an experiment
+func (c *experimentClient) Stop(id string) error {
+	return c.stopRuns(id, nil)
+}
+
+// StopRun stops a single run of an experiment
+func (c *experimentClient) StopRun(experimentID string, runID string) error {
+	if runID == "" {
+		return fmt.Errorf("experiment run ID cannot be empty")
+	}
+	return c.stopRuns(experimentID, &runID)
+}
+
+func (c *experimentClient) stopRuns(id string, runID *string) error {
+	if c.credentials.Endpoint == "" {
+		return fmt.Errorf("endpoint not set in credentials")
+	}
+
+	if c.credentials.ProjectID == "" {
+		return fmt.Errorf("project ID not set in credentials")
+	}
+
+	if id == "" {
+		return fmt.Errorf("experiment ID cannot be empty")
+	}
+
+	response, err := experiment.StopExperimentRuns(c.credentials.ProjectID, id, runID, c.credentials)
+	if err != nil {
+		return fmt.Errorf("failed to stop experiment runs: %w", err)
+	}
+
+	if !response.IsStopped {
+		return fmt.Errorf("stopping experiment runs was not successful")
+	}
+
+	return nil
+}
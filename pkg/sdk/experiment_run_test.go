@@ -0,0 +1,21 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitForRunThrottle(t *testing.T) {
+	c := &experimentClient{credentials: types.Credentials{Endpoint: "http://unused", ProjectID: "project"}}
+	limited := errors.New("rate limited")
+
+	_, err := c.WaitForRun(context.Background(), "notify", WaitOptions{
+		Throttle: func(context.Context) error { return limited },
+	})
+	assert.ErrorIs(t, err, limited)
+	assert.ErrorContains(t, err, "while waiting for run notify")
+}
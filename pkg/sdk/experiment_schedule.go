@@ -0,0 +1,214 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/experiment"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/cron"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/manifest"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+// CronSchedule describes when a cron experiment runs
+type CronSchedule struct {
+	// Expression is a five field cron expression or a descriptor such as @daily
+	Expression string
+
+	// Timezone is an IANA timezone name, the schedule is evaluated in UTC when empty
+	Timezone string
+}
+
+// ScheduledExperiment is a cron experiment along with its schedule
+type ScheduledExperiment struct {
+	Experiment *models.Experiment
+	Schedule   CronSchedule
+	Suspended  bool
+	// NextRun is the next fire time, zero when the schedule is suspended or never fires
+	NextRun time.Time
+}
+
+// CreateCron saves an experiment which runs on a cron schedule. The manifest may
+// be a Workflow, which is converted into a CronWorkflow, or a CronWorkflow whose
+// schedule is replaced.
+func (c *experimentClient) CreateCron(name string, schedule CronSchedule, experimentConfig models.SaveChaosExperimentRequest) (string, error) {
+	if c.credentials.Endpoint == "" {
+		return "", fmt.Errorf("endpoint not set in credentials")
+	}
+
+	if c.credentials.ProjectID == "" {
+		return "", fmt.Errorf("project ID not set in credentials")
+	}
+
+	request := experimentConfig
+
+	if request.Name == "" {
+		request.Name = name
+	}
+
+	if request.Description == "" {
+		request.Description = fmt.Sprintf("Experiment created via Litmus SDK: %s", name)
+	}
+
+	cronManifest, err := manifest.ToCronWorkflow(request.Manifest, schedule.Expression, schedule.Timezone)
+	if err != nil {
+		return "", fmt.Errorf("invalid cron experiment: %w", err)
+	}
+	request.Manifest = cronManifest
+
+	experimentType := models.ExperimentTypeCronExperiment
+	request.Type = &experimentType
+
+	saveResp, err := experiment.SaveExperiment(c.credentials.ProjectID, request, c.credentials)
+	if err != nil {
+		return "", fmt.Errorf("failed to create cron experiment: %w", err)
+	}
+
+	return saveResp.Message, nil
+}
+
+// PauseSchedule disables the schedule of a cron experiment
+func (c *experimentClient) PauseSchedule(id string) error {
+	return c.setScheduleState(id, true)
+}
+
+// ResumeSchedule enables the schedule of a cron experiment
+func (c *experimentClient) ResumeSchedule(id string) error {
+	return c.setScheduleState(id, false)
+}
+
+func (c *experimentClient) setScheduleState(id string, disable bool) error {
+	if c.credentials.Endpoint == "" {
+		return fmt.Errorf("endpoint not set in credentials")
+	}
+
+	if c.credentials.ProjectID == "" {
+		return fmt.Errorf("project ID not set in credentials")
+	}
+
+	if id == "" {
+		return fmt.Errorf("experiment ID cannot be empty")
+	}
+
+	response, err := experiment.UpdateCronExperimentState(c.credentials.ProjectID, id, disable, c.credentials)
+	if err != nil {
+		return fmt.Errorf("failed to update experiment schedule: %w", err)
+	}
+
+	if !response.IsUpdated {
+		return fmt.Errorf("experiment schedule update was not successful")
+	}
+
+	return nil
+}
+
+// ListScheduled retrieves all cron experiments along with their schedules
+func (c *experimentClient) ListScheduled() ([]ScheduledExperiment, error) {
+	experiments, err := c.Select(ExperimentSelector{ScheduleType: models.ScheduleTypeCron})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	scheduled := make([]ScheduledExperiment, 0, len(experiments))
+	for _, exp := range experiments {
+		item := ScheduledExperiment{
+			Experiment: exp,
+			Schedule:   CronSchedule{Expression: exp.CronSyntax},
+		}
+
+		if settings, err := manifest.CronSettingsOf(exp.ExperimentManifest); err == nil {
+			if settings.Schedule != "" {
+				item.Schedule.Expression = settings.Schedule
+			}
+			item.Schedule.Timezone = settings.Timezone
+			item.Suspended = settings.Suspended
+		}
+
+		if !item.Suspended {
+			if times, err := nextFireTimes(item.Schedule, now, 1); err == nil && len(times) > 0 {
+				item.NextRun = times[0]
+			}
+		}
+
+		scheduled = append(scheduled, item)
+	}
+
+	return scheduled, nil
+}
+
+// PreviewSchedule computes the next fire times of a cron experiment locally
+func (c *experimentClient) PreviewSchedule(id string, count int) ([]time.Time, error) {
+	if c.credentials.Endpoint == "" {
+		return nil, fmt.Errorf("endpoint not set in credentials")
+	}
+
+	if c.credentials.ProjectID == "" {
+		return nil, fmt.Errorf("project ID not set in credentials")
+	}
+
+	if id == "" {
+		return nil, fmt.Errorf("experiment ID cannot be empty")
+	}
+
+	if count <= 0 {
+		return nil, fmt.Errorf("count must be positive")
+	}
+
+	response, err := experiment.GetExperiment(c.credentials.ProjectID, id, c.credentials)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get experiment: %w", err)
+	}
+
+	details := response.ExperimentDetails.ExperimentDetails
+	if details == nil {
+		return nil, fmt.Errorf("experiment not found with ID: %s", id)
+	}
+
+	schedule := CronSchedule{Expression: details.CronSyntax}
+	if settings, err := manifest.CronSettingsOf(details.ExperimentManifest); err == nil {
+		if settings.Schedule != "" {
+			schedule.Expression = settings.Schedule
+		}
+		schedule.Timezone = settings.Timezone
+	}
+
+	if schedule.Expression == "" {
+		return nil, fmt.Errorf("experiment %s is not a cron experiment", id)
+	}
+
+	return nextFireTimes(schedule, time.Now(), count)
+}
+
+// nextFireTimes evaluates a schedule in its timezone
+func nextFireTimes(schedule CronSchedule, from time.Time, count int) ([]time.Time, error) {
+	parsed, err := cron.Parse(schedule.Expression)
+	if err != nil {
+		return nil, err
+	}
+
+	location := time.UTC
+	if schedule.Timezone != "" {
+		location, err = time.LoadLocation(schedule.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timezone %q: %w", schedule.Timezone, err)
+		}
+	}
+
+	return parsed.NextN(from.In(location), count), nil
+}
@@ -0,0 +1,229 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/experiment"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+// maxTrendBuckets bounds the number of points of a resiliency trend
+const maxTrendBuckets = 10000
+
+// ResiliencyTrendOptions describes the window over which resiliency scores are aggregated
+type ResiliencyTrendOptions struct {
+	// ExperimentIDs limits the trend to the given experiments, all experiments of the project are considered when empty
+	ExperimentIDs []string
+
+	// Since is the start of the window
+	Since time.Time
+
+	// Until is the end of the window, defaults to now
+	Until time.Time
+
+	// Bucket is the width of each trend point, defaults to one day. The window may span at most 10000 buckets.
+	Bucket time.Duration
+}
+
+// ResiliencyTrendPoint aggregates the resiliency scores of the runs which finished within one bucket
+type ResiliencyTrendPoint struct {
+	Start   time.Time
+	Runs    int
+	Average float64
+	Min     float64
+	Max     float64
+}
+
+// ResiliencyTrend is the aggregated resiliency score of experiment runs over a time window
+type ResiliencyTrend struct {
+	Since  time.Time
+	Until  time.Time
+	Bucket time.Duration
+	// Points holds one entry per bucket in chronological order, buckets without runs have Runs set to zero
+	Points  []ResiliencyTrendPoint
+	Runs    int
+	Average float64
+}
+
+// GetStats retrieves experiment statistics of the project
+func (c *experimentClient) GetStats() (models.GetExperimentStatsResponse, error) {
+	if c.credentials.Endpoint == "" {
+		return models.GetExperimentStatsResponse{}, fmt.Errorf("endpoint not set in credentials")
+	}
+
+	if c.credentials.ProjectID == "" {
+		return models.GetExperimentStatsResponse{}, fmt.Errorf("project ID not set in credentials")
+	}
+
+	response, err := experiment.GetExperimentStats(c.credentials.ProjectID, c.credentials)
+	if err != nil {
+		return models.GetExperimentStatsResponse{}, fmt.Errorf("failed to get experiment stats: %w", err)
+	}
+
+	return response.ExperimentStats, nil
+}
+
+// GetRunStats retrieves experiment run statistics of the project
+func (c *experimentClient) GetRunStats() (models.GetExperimentRunStatsResponse, error) {
+	if c.credentials.Endpoint == "" {
+		return models.GetExperimentRunStatsResponse{}, fmt.Errorf("endpoint not set in credentials")
+	}
+
+	if c.credentials.ProjectID == "" {
+		return models.GetExperimentRunStatsResponse{}, fmt.Errorf("project ID not set in credentials")
+	}
+
+	response, err := experiment.GetExperimentRunStats(c.credentials.ProjectID, c.credentials)
+	if err != nil {
+		return models.GetExperimentRunStatsResponse{}, fmt.Errorf("failed to get experiment run stats: %w", err)
+	}
+
+	return response.ExperimentRunStats, nil
+}
+
+// ResiliencyTrend aggregates resiliency scores of experiment runs over a time window
+func (c *experimentClient) ResiliencyTrend(options ResiliencyTrendOptions) (ResiliencyTrend, error) {
+	if c.credentials.Endpoint == "" {
+		return ResiliencyTrend{}, fmt.Errorf("endpoint not set in credentials")
+	}
+
+	if c.credentials.ProjectID == "" {
+		return ResiliencyTrend{}, fmt.Errorf("project ID not set in credentials")
+	}
+
+	if options.Since.IsZero() {
+		return ResiliencyTrend{}, fmt.Errorf("start of the window must be set")
+	}
+
+	if options.Until.IsZero() {
+		options.Until = time.Now()
+	}
+
+	if !options.Since.Before(options.Until) {
+		return ResiliencyTrend{}, fmt.Errorf("start of the window must be before its end")
+	}
+
+	if options.Bucket <= 0 {
+		options.Bucket = 24 * time.Hour
+	}
+
+	if _, err := trendBuckets(options); err != nil {
+		return ResiliencyTrend{}, err
+	}
+
+	endDate := strconv.FormatInt(options.Until.UnixMilli(), 10)
+	request := models.ListExperimentRunRequest{
+		Filter: &models.ExperimentRunFilterInput{
+			DateRange: &models.DateRange{
+				StartDate: strconv.FormatInt(options.Since.UnixMilli(), 10),
+				EndDate:   &endDate,
+			},
+		},
+	}
+	for i := range options.ExperimentIDs {
+		request.ExperimentIDs = append(request.ExperimentIDs, &options.ExperimentIDs[i])
+	}
+
+	runs, err := c.listAllRuns(request, experiment.GetExperimentRunsList)
+	if err != nil {
+		return ResiliencyTrend{}, fmt.Errorf("failed to list experiment runs: %w", err)
+	}
+
+	return aggregateResiliency(runs, options)
+}
+
+// trendBuckets returns the number of buckets covering the window, rejecting
+// windows which would need more than maxTrendBuckets points
+func trendBuckets(options ResiliencyTrendOptions) (int, error) {
+	window := options.Until.Sub(options.Since)
+	buckets := window / options.Bucket
+	if window%options.Bucket != 0 {
+		buckets++
+	}
+	if buckets > maxTrendBuckets {
+		return 0, fmt.Errorf("window of %s split into buckets of %s exceeds the maximum of %d points", window, options.Bucket, maxTrendBuckets)
+	}
+	return int(buckets), nil
+}
+
+// aggregateResiliency buckets the scores of finished runs by their last update time
+func aggregateResiliency(runs []*models.ExperimentRun, options ResiliencyTrendOptions) (ResiliencyTrend, error) {
+	buckets, err := trendBuckets(options)
+	if err != nil {
+		return ResiliencyTrend{}, err
+	}
+
+	trend := ResiliencyTrend{
+		Since:  options.Since,
+		Until:  options.Until,
+		Bucket: options.Bucket,
+	}
+
+	trend.Points = make([]ResiliencyTrendPoint, buckets)
+	sums := make([]float64, buckets)
+	for i := range trend.Points {
+		trend.Points[i].Start = options.Since.Add(time.Duration(i) * options.Bucket)
+	}
+
+	var total float64
+	for _, run := range runs {
+		if run == nil || run.ResiliencyScore == nil {
+			continue
+		}
+		if run.Phase == models.ExperimentRunStatusRunning || run.Phase == models.ExperimentRunStatusQueued {
+			continue
+		}
+
+		updatedAt := parseTimestamp(run.UpdatedAt)
+		if updatedAt.Before(options.Since) || updatedAt.After(options.Until) {
+			continue
+		}
+
+		i := int(updatedAt.Sub(options.Since) / options.Bucket)
+		if i >= buckets {
+			i = buckets - 1
+		}
+
+		score := *run.ResiliencyScore
+		point := &trend.Points[i]
+		if point.Runs == 0 || score < point.Min {
+			point.Min = score
+		}
+		if point.Runs == 0 || score > point.Max {
+			point.Max = score
+		}
+		point.Runs++
+		sums[i] += score
+
+		trend.Runs++
+		total += score
+	}
+
+	for i := range trend.Points {
+		if trend.Points[i].Runs > 0 {
+			trend.Points[i].Average = sums[i] / float64(trend.Points[i].Runs)
+		}
+	}
+	if trend.Runs > 0 {
+		trend.Average = total / float64(trend.Runs)
+	}
+
+	return trend, nil
+}
@@ -0,0 +1,55 @@
+package sdk
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregateResiliency(t *testing.T) {
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	options := ResiliencyTrendOptions{Since: since, Until: since.Add(60 * time.Hour), Bucket: 24 * time.Hour}
+	run := func(phase models.ExperimentRunStatus, after time.Duration, score *float64) *models.ExperimentRun {
+		updatedAt := strconv.FormatInt(since.Add(after).UnixMilli(), 10)
+		return &models.ExperimentRun{Phase: phase, UpdatedAt: updatedAt, ResiliencyScore: score}
+	}
+	score := func(s float64) *float64 { return &s }
+
+	trend, err := aggregateResiliency([]*models.ExperimentRun{
+		run(models.ExperimentRunStatusCompleted, time.Hour, score(100)),
+		run(models.ExperimentRunStatusCompletedWithError, 2*time.Hour, score(50)),
+		run(models.ExperimentRunStatusError, 50*time.Hour, score(20)),
+		run(models.ExperimentRunStatusCompleted, 60*time.Hour, score(40)),
+		run(models.ExperimentRunStatusRunning, 3*time.Hour, score(0)),
+		run(models.ExperimentRunStatusCompleted, 4*time.Hour, nil),
+		run(models.ExperimentRunStatusCompleted, -time.Hour, score(0)),
+		run(models.ExperimentRunStatusCompleted, 61*time.Hour, score(0)),
+		nil,
+	}, options)
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, trend.Runs)
+	assert.Equal(t, 52.5, trend.Average)
+	require.Len(t, trend.Points, 3, "a partial last bucket is kept")
+
+	assert.Equal(t, ResiliencyTrendPoint{Start: since, Runs: 2, Average: 75, Min: 50, Max: 100}, trend.Points[0])
+	assert.Equal(t, ResiliencyTrendPoint{Start: since.Add(24 * time.Hour)}, trend.Points[1])
+	assert.Equal(t, ResiliencyTrendPoint{Start: since.Add(48 * time.Hour), Runs: 2, Average: 30, Min: 20, Max: 40}, trend.Points[2],
+		"a run finishing at the end of the window falls into the last bucket")
+}
+
+func TestAggregateResiliencyBucketLimit(t *testing.T) {
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := aggregateResiliency(nil, ResiliencyTrendOptions{Since: since, Until: since.AddDate(1, 0, 0), Bucket: time.Second})
+	assert.ErrorContains(t, err, "exceeds the maximum of 10000 points")
+
+	trend, err := aggregateResiliency(nil, ResiliencyTrendOptions{Since: since, Until: since.Add(maxTrendBuckets * time.Minute), Bucket: time.Minute})
+	require.NoError(t, err)
+	assert.Len(t, trend.Points, maxTrendBuckets)
+	assert.Zero(t, trend.Runs)
+}
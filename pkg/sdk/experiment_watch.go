@@ -0,0 +1,258 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/experiment"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+const (
+	defaultWatchInterval = 15 * time.Second
+	defaultWatchResync   = 5 * time.Minute
+	watchBufferSize      = 64
+)
+
+// RunEventType is the kind of change a RunEvent describes
+type RunEventType string
+
+const (
+	// RunCreated is sent the first time a run is seen
+	RunCreated RunEventType = "RunCreated"
+
+	// PhaseChanged is sent when the phase of a run changes
+	PhaseChanged RunEventType = "PhaseChanged"
+
+	// RunCompleted is sent when a run reaches a terminal phase
+	RunCompleted RunEventType = "RunCompleted"
+
+	// ScoreUpdated is sent when the resiliency score of a run changes
+	ScoreUpdated RunEventType = "ScoreUpdated"
+
+	// WatchError is sent when a snapshot could not be fetched; the watch keeps going
+	WatchError RunEventType = "WatchError"
+)
+
+// RunEvent is a change to an experiment run observed by Watch
+type RunEvent struct {
+	Type RunEventType
+
+	// Run is the latest state of the run, nil for WatchError events
+	Run *models.ExperimentRun
+
+	// PreviousPhase is the phase before a PhaseChanged or RunCompleted event
+	PreviousPhase models.ExperimentRunStatus
+
+	// PreviousScore is the resiliency score before a ScoreUpdated event
+	PreviousScore *float64
+
+	// Err is set for WatchError events
+	Err error
+}
+
+// WatchFilter selects the runs Watch reports on and how often it polls
+type WatchFilter struct {
+	// ExperimentIDs limits the watch to runs of the given experiments
+	ExperimentIDs []string
+
+	// Filter further narrows the runs, its DateRange is managed by the watch and ignored
+	Filter *models.ExperimentRunFilterInput
+
+	// Interval is the time between two snapshots, defaults to 15 seconds
+	Interval time.Duration
+
+	// Resync is the time between two full snapshots, defaults to 5 minutes. In
+	// between, only runs updated since the last snapshot are fetched. A value
+	// no longer than Interval fetches a full snapshot every interval.
+	Resync time.Duration
+
+	// IgnoreExisting suppresses the RunCreated events of runs which exist when the watch starts
+	IgnoreExisting bool
+}
+
+// Watch streams changes to experiment runs by diffing successive snapshots of
+// the run list. The channel is closed once the context is done.
+func (c *experimentClient) Watch(ctx context.Context, filter WatchFilter) (<-chan RunEvent, error) {
+	if c.credentials.Endpoint == "" {
+		return nil, fmt.Errorf("endpoint not set in credentials")
+	}
+
+	if c.credentials.ProjectID == "" {
+		return nil, fmt.Errorf("project ID not set in credentials")
+	}
+
+	if filter.Interval <= 0 {
+		filter.Interval = defaultWatchInterval
+	}
+
+	if filter.Resync <= 0 {
+		filter.Resync = defaultWatchResync
+	}
+
+	events := make(chan RunEvent, watchBufferSize)
+	go c.watch(ctx, filter, events)
+	return events, nil
+}
+
+// watch polls the run list until the context is done
+func (c *experimentClient) watch(ctx context.Context, filter WatchFilter, events chan<- RunEvent) {
+	defer close(events)
+
+	w := runWatcher{known: make(map[string]*models.ExperimentRun)}
+	initial := true
+	var lastResync time.Time
+
+	ticker := time.NewTicker(filter.Interval)
+	defer ticker.Stop()
+
+	for {
+		full := initial || time.Since(lastResync) >= filter.Resync
+		runs, err := c.listAllRuns(watchRequest(filter, w.latest, full), experiment.GetExperimentRunsList)
+
+		var batch []RunEvent
+		if err != nil {
+			batch = []RunEvent{{Type: WatchError, Err: fmt.Errorf("failed to list experiment runs: %w", err)}}
+		} else {
+			batch = w.observe(runs, initial, initial && filter.IgnoreExisting)
+			initial = false
+			if full {
+				w.forgetMissing(runs)
+				lastResync = time.Now()
+			}
+		}
+
+		for _, event := range batch {
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// watchRequest builds the list request of a snapshot. Incremental snapshots only
+// fetch runs updated at or after the latest update seen so far.
+func watchRequest(filter WatchFilter, latest int64, full bool) models.ListExperimentRunRequest {
+	request := models.ListExperimentRunRequest{}
+	for i := range filter.ExperimentIDs {
+		request.ExperimentIDs = append(request.ExperimentIDs, &filter.ExperimentIDs[i])
+	}
+
+	if filter.Filter != nil {
+		f := *filter.Filter
+		f.DateRange = nil
+		request.Filter = &f
+	}
+
+	if !full && latest > 0 {
+		if request.Filter == nil {
+			request.Filter = &models.ExperimentRunFilterInput{}
+		}
+		request.Filter.DateRange = &models.DateRange{StartDate: strconv.FormatInt(latest, 10)}
+	}
+	return request
+}
+
+// runWatcher remembers the last state of every run seen by a watch
+type runWatcher struct {
+	known  map[string]*models.ExperimentRun
+	latest int64
+}
+
+// observe diffs a snapshot against the known runs and returns the resulting
+// events in chronological order of the runs' updates. Runs missing from the
+// snapshot are kept, since incremental snapshots only contain updated runs;
+// forgetMissing drops them once a full snapshot no longer contains them.
+func (w *runWatcher) observe(runs []*models.ExperimentRun, initial bool, quiet bool) []RunEvent {
+	sorted := make([]*models.ExperimentRun, 0, len(runs))
+	for _, run := range runs {
+		if run != nil && run.ExperimentRunID != "" {
+			sorted = append(sorted, run)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return timestampBefore(sorted[i].UpdatedAt, sorted[j].UpdatedAt)
+	})
+
+	var events []RunEvent
+	for _, run := range sorted {
+		if updatedAt, err := strconv.ParseInt(run.UpdatedAt, 10, 64); err == nil && updatedAt > w.latest {
+			w.latest = updatedAt
+		}
+
+		previous, seen := w.known[run.ExperimentRunID]
+		w.known[run.ExperimentRunID] = run
+
+		if !seen {
+			if quiet {
+				continue
+			}
+			events = append(events, RunEvent{Type: RunCreated, Run: run})
+			if !initial && IsTerminalPhase(run.Phase) {
+				events = append(events, RunEvent{Type: RunCompleted, Run: run})
+			}
+			continue
+		}
+
+		if previous.Phase != run.Phase {
+			events = append(events, RunEvent{Type: PhaseChanged, Run: run, PreviousPhase: previous.Phase})
+			if IsTerminalPhase(run.Phase) && !IsTerminalPhase(previous.Phase) {
+				events = append(events, RunEvent{Type: RunCompleted, Run: run, PreviousPhase: previous.Phase})
+			}
+		}
+
+		if !sameScore(previous.ResiliencyScore, run.ResiliencyScore) {
+			events = append(events, RunEvent{Type: ScoreUpdated, Run: run, PreviousScore: previous.ResiliencyScore})
+		}
+	}
+	return events
+}
+
+// forgetMissing drops the known runs which are missing from a full snapshot,
+// such as deleted runs, so that a long-lived watch does not grow without bound
+func (w *runWatcher) forgetMissing(runs []*models.ExperimentRun) {
+	present := make(map[string]bool, len(runs))
+	for _, run := range runs {
+		if run != nil {
+			present[run.ExperimentRunID] = true
+		}
+	}
+	for id := range w.known {
+		if !present[id] {
+			delete(w.known, id)
+		}
+	}
+}
+
+func sameScore(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
@@ -0,0 +1,99 @@
+package sdk
+
+import (
+	"testing"
+
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func watchedRun(id string, phase models.ExperimentRunStatus, updatedAt string, score *float64) *models.ExperimentRun {
+	return &models.ExperimentRun{ExperimentRunID: id, Phase: phase, UpdatedAt: updatedAt, ResiliencyScore: score}
+}
+
+func eventTypes(events []RunEvent) []RunEventType {
+	var types []RunEventType
+	for _, event := range events {
+		types = append(types, event.Type)
+	}
+	return types
+}
+
+func TestRunWatcherObserve(t *testing.T) {
+	half, full := 50.0, 100.0
+	w := runWatcher{known: make(map[string]*models.ExperimentRun)}
+
+	// Existing runs are reported as created, even if they already finished
+	events := w.observe([]*models.ExperimentRun{
+		watchedRun("b", models.ExperimentRunStatusCompleted, "2000", &full),
+		watchedRun("a", models.ExperimentRunStatusRunning, "1000", nil),
+	}, true, false)
+	assert.Equal(t, []RunEventType{RunCreated, RunCreated}, eventTypes(events))
+	assert.Equal(t, "a", events[0].Run.ExperimentRunID)
+	assert.Equal(t, int64(2000), w.latest)
+
+	// An unchanged snapshot produces no events
+	assert.Empty(t, w.observe([]*models.ExperimentRun{watchedRun("b", models.ExperimentRunStatusCompleted, "2000", &full)}, false, false))
+
+	// A run finishing with a score
+	events = w.observe([]*models.ExperimentRun{watchedRun("a", models.ExperimentRunStatusCompletedWithError, "3000", &half)}, false, false)
+	assert.Equal(t, []RunEventType{PhaseChanged, RunCompleted, ScoreUpdated}, eventTypes(events))
+	assert.Equal(t, models.ExperimentRunStatusRunning, events[0].PreviousPhase)
+	assert.Nil(t, events[2].PreviousScore)
+	assert.Equal(t, int64(3000), w.latest)
+
+	// A run which started and finished between two snapshots
+	events = w.observe([]*models.ExperimentRun{watchedRun("c", models.ExperimentRunStatusError, "4000", nil)}, false, false)
+	assert.Equal(t, []RunEventType{RunCreated, RunCompleted}, eventTypes(events))
+}
+
+func TestRunWatcherIgnoreExisting(t *testing.T) {
+	w := runWatcher{known: make(map[string]*models.ExperimentRun)}
+
+	assert.Empty(t, w.observe([]*models.ExperimentRun{watchedRun("a", models.ExperimentRunStatusRunning, "1000", nil)}, true, true))
+
+	events := w.observe([]*models.ExperimentRun{watchedRun("a", models.ExperimentRunStatusStopped, "2000", nil)}, false, false)
+	assert.Equal(t, []RunEventType{PhaseChanged, RunCompleted}, eventTypes(events))
+}
+
+func TestRunWatcherForgetMissing(t *testing.T) {
+	w := runWatcher{known: make(map[string]*models.ExperimentRun)}
+	w.observe([]*models.ExperimentRun{
+		watchedRun("a", models.ExperimentRunStatusCompleted, "1000", nil),
+		watchedRun("b", models.ExperimentRunStatusRunning, "2000", nil),
+	}, true, false)
+
+	// An incremental snapshot keeps runs it does not contain
+	snapshot := []*models.ExperimentRun{watchedRun("b", models.ExperimentRunStatusRunning, "2000", nil)}
+	assert.Empty(t, w.observe(snapshot, false, false))
+	assert.Len(t, w.known, 2)
+
+	// A full snapshot drops them
+	w.forgetMissing(snapshot)
+	assert.Len(t, w.known, 1)
+	assert.Contains(t, w.known, "b")
+
+	// A run which shows up again after being dropped is reported as new
+	events := w.observe([]*models.ExperimentRun{watchedRun("a", models.ExperimentRunStatusCompleted, "3000", nil)}, false, false)
+	assert.Equal(t, []RunEventType{RunCreated, RunCompleted}, eventTypes(events))
+}
+
+func TestWatchRequest(t *testing.T) {
+	status := "Running"
+	filter := WatchFilter{
+		ExperimentIDs: []string{"exp-1"},
+		Filter: &models.ExperimentRunFilterInput{
+			ExperimentRunStatus: []*string{&status},
+			DateRange:           &models.DateRange{StartDate: "1"},
+		},
+	}
+
+	full := watchRequest(filter, 5000, true)
+	assert.Equal(t, "exp-1", *full.ExperimentIDs[0])
+	assert.Nil(t, full.Filter.DateRange)
+	assert.NotNil(t, filter.Filter.DateRange, "the caller's filter must not be modified")
+
+	incremental := watchRequest(filter, 5000, false)
+	assert.Equal(t, "5000", incremental.Filter.DateRange.StartDate)
+	assert.Equal(t, []*string{&status}, incremental.Filter.ExperimentRunStatus)
+}
@@ -0,0 +1,78 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"fmt"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/imageregistry"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/types"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+// ImageRegistryClient defines the interface for image registry operations
+type ImageRegistryClient interface {
+	// List retrieves the image registry settings of the project
+	List() ([]*models.ImageRegistryResponse, error)
+
+	// Create saves image registry settings in the project
+	Create(request models.ImageRegistryInput) (models.ImageRegistryResponse, error)
+}
+
+// imageRegistryClient implements the ImageRegistryClient interface
+type imageRegistryClient struct {
+	credentials types.Credentials
+}
+
+// List retrieves the image registry settings of the project
+func (c *imageRegistryClient) List() ([]*models.ImageRegistryResponse, error) {
+	if c.credentials.Endpoint == "" {
+		return nil, fmt.Errorf("endpoint not set in credentials")
+	}
+
+	if c.credentials.ProjectID == "" {
+		return nil, fmt.Errorf("project ID not set in credentials")
+	}
+
+	response, err := imageregistry.ListImageRegistry(c.credentials.ProjectID, c.credentials)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list image registries: %w", err)
+	}
+
+	return response.ListImageRegistry, nil
+}
+
+// Create saves image registry settings in the project
+func (c *imageRegistryClient) Create(request models.ImageRegistryInput) (models.ImageRegistryResponse, error) {
+	if c.credentials.Endpoint == "" {
+		return models.ImageRegistryResponse{}, fmt.Errorf("endpoint not set in credentials")
+	}
+
+	if c.credentials.ProjectID == "" {
+		return models.ImageRegistryResponse{}, fmt.Errorf("project ID not set in credentials")
+	}
+
+	if request.ImageRegistryName == "" {
+		return models.ImageRegistryResponse{}, fmt.Errorf("image registry name cannot be empty")
+	}
+
+	response, err := imageregistry.CreateImageRegistry(c.credentials.ProjectID, request, c.credentials)
+	if err != nil {
+		return models.ImageRegistryResponse{}, fmt.Errorf("failed to create image registry: %w", err)
+	}
+
+	return response.CreateImageRegistry, nil
+}
@@ -17,8 +17,10 @@ package sdk
 
 import (
 	"fmt"
+	"iter"
 
 	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/probe"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/manifest"
 	"github.com/litmuschaos/litmus-go-sdk/pkg/types"
 	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
 )
@@ -26,19 +28,46 @@ import (
 // ProbeClient defines the interface for probe operations
 type ProbeClient interface {
 	// Create creates a new probe
-	Create(request probe.ProbeRequest, projectID string) (probe.Probe, error)
+	Create(request probe.ProbeRequest, projectID string) (models.Probe, error)
+
+	// Update replaces the properties of an existing probe, identified by the request name
+	Update(projectID string, request probe.ProbeRequest) error
 
 	// List retrieves all probes
 	List(projectID string) ([]models.Probe, error)
 
+	// Filter retrieves the probes matching the options
+	Filter(projectID string, options ProbeListOptions) ([]models.Probe, error)
+
+	// Iterate yields the probes matching the options with their properties, fetched a page at a time
+	Iterate(projectID string, options ProbeListOptions) iter.Seq2[models.Probe, error]
+
+	// ApplyFile creates and updates the probes of a probe definition file
+	ApplyFile(projectID string, path string, options ProbeApplyOptions) ([]Change, error)
+
+	// Export renders probes as a probe definition file
+	Export(projectID string, names []string) ([]byte, error)
+
+	// AttachToManifest references existing probes from faults of an experiment manifest
+	AttachToManifest(projectID string, experimentManifest string, attachments ...manifest.ProbeAttachment) (string, error)
+
 	// Delete removes a probe
 	Delete(projectID string, id string) error
 
+	// DeleteWithOptions removes a probe, optionally refusing to when experiments reference it
+	DeleteWithOptions(projectID string, id string, options ProbeDeleteOptions) error
+
+	// References retrieves the experiment faults using a probe and its recent verdicts in them
+	References(projectID string, id string) (ProbeReferences, error)
+
+	// ListInRun retrieves the probes attached to a fault of an experiment run
+	ListInRun(projectID string, experimentRunID string, faultName string) ([]*models.GetProbesInExperimentRunResponse, error)
+
 	// Get retrieves probe details
 	Get(projectID string, id string) (models.Probe, error)
 
-	// GetProbeYAML retrieves the YAML configuration for a probe
-	GetProbeYAML(projectID string, id string, request models.GetProbeYAMLRequest) (string, error)
+	// GetProbeYAML retrieves the definition of a probe in a mode, as embedded in ChaosEngines
+	GetProbeYAML(projectID string, id string, mode probe.ProbeMode) (probe.ProbeSpec, error)
 }
 
 // probeClient implements the ProbeClient interface
@@ -48,41 +77,47 @@ type probeClient struct {
 
 // List retrieves all probes
 func (c *probeClient) List(projectID string) ([]models.Probe, error) {
+	return c.Filter(projectID, ProbeListOptions{})
+}
+
+// Create creates a new probe
+func (c *probeClient) Create(request probe.ProbeRequest, projectID string) (models.Probe, error) {
 	if c.credentials.Endpoint == "" {
-		return nil, fmt.Errorf("endpoint not set in credentials")
+		return models.Probe{}, fmt.Errorf("endpoint not set in credentials")
 	}
 
 	if projectID == "" {
-		return nil, fmt.Errorf("project ID cannot be empty")
+		return models.Probe{}, fmt.Errorf("project ID cannot be empty")
 	}
 
-	// Use all probe types as no specific type is requested
-	var probeTypes []*models.ProbeType
-	
-	response, err := probe.ListProbeRequest(projectID, probeTypes, c.credentials)
+	response, err := probe.AddProbe(request, projectID, c.credentials)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list probes: %w", err)
+		return models.Probe{}, fmt.Errorf("failed to create probe: %w", err)
 	}
 
-	return response.Data.Probes, nil
+	return response, nil
 }
 
-// Create creates a new probe
-func (c *probeClient) Create(request probe.ProbeRequest, projectID string) (probe.Probe, error) {
+// Update replaces the properties of an existing probe. Unlike deleting and
+// recreating it, this works while experiments reference the probe.
+func (c *probeClient) Update(projectID string, request probe.ProbeRequest) error {
 	if c.credentials.Endpoint == "" {
-		return probe.Probe{}, fmt.Errorf("endpoint not set in credentials")
+		return fmt.Errorf("endpoint not set in credentials")
 	}
 
 	if projectID == "" {
-		return probe.Probe{}, fmt.Errorf("project ID cannot be empty")
+		return fmt.Errorf("project ID cannot be empty")
 	}
 
-	response, err := probe.CreateProbeRequest(request, projectID, c.credentials)
-	if err != nil {
-		return probe.Probe{}, fmt.Errorf("failed to create probe: %w", err)
+	if request.Name == "" {
+		return fmt.Errorf("probe name cannot be empty")
 	}
 
-	return *response, nil
+	if _, err := probe.UpdateProbeRequest(request, projectID, c.credentials); err != nil {
+		return fmt.Errorf("failed to update probe: %w", err)
+	}
+
+	return nil
 }
 
 // Delete removes a probe
@@ -134,29 +169,25 @@ func (c *probeClient) Get(projectID string, id string) (models.Probe, error) {
 	return response.Data.GetProbe, nil
 }
 
-// GetProbeYAML retrieves the YAML configuration for a probe
-func (c *probeClient) GetProbeYAML(projectID string, id string, request models.GetProbeYAMLRequest) (string, error) {
+// GetProbeYAML retrieves the definition of a probe in the given mode, as
+// ChaosCenter embeds it in the ChaosEngine of the faults referencing the probe
+func (c *probeClient) GetProbeYAML(projectID string, id string, mode probe.ProbeMode) (probe.ProbeSpec, error) {
 	if c.credentials.Endpoint == "" {
-		return "", fmt.Errorf("endpoint not set in credentials")
+		return probe.ProbeSpec{}, fmt.Errorf("endpoint not set in credentials")
 	}
 
 	if projectID == "" {
-		return "", fmt.Errorf("project ID cannot be empty")
+		return probe.ProbeSpec{}, fmt.Errorf("project ID cannot be empty")
 	}
 
 	if id == "" {
-		return "", fmt.Errorf("probe ID cannot be empty")
-	}
-
-	// Ensure probe name is set
-	if request.ProbeName == "" {
-		request.ProbeName = id
+		return probe.ProbeSpec{}, fmt.Errorf("probe ID cannot be empty")
 	}
 
-	response, err := probe.GetProbeYAMLRequest(projectID, request, c.credentials)
+	spec, err := probe.GetProbeYAML(projectID, id, mode, c.credentials)
 	if err != nil {
-		return "", fmt.Errorf("failed to execute probe: %w", err)
+		return probe.ProbeSpec{}, fmt.Errorf("failed to get probe YAML: %w", err)
 	}
 
-	return response.Data.GetProbeYAML, nil
+	return spec, nil
 }
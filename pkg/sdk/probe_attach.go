@@ -0,0 +1,79 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/manifest"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+// AttachToManifest attaches probes to faults of an experiment manifest, see
+// manifest.AttachProbes. Every probe must exist in the project. The definition
+// of each probe in its mode is fetched with GetProbeYAML and embedded in the
+// ChaosEngine, so the manifest matches what ChaosCenter generates for it.
+// Probes are detached with manifest.DetachProbes, which needs no server.
+func (c *probeClient) AttachToManifest(projectID string, experimentManifest string, attachments ...manifest.ProbeAttachment) (string, error) {
+	names := map[string]bool{}
+	for _, a := range attachments {
+		if !a.Mode.IsValid() {
+			return "", fmt.Errorf("invalid mode %q of probe %s, expected one of %v", a.Mode, a.Probe, models.AllMode)
+		}
+		names[a.Probe] = true
+	}
+
+	requested := make([]string, 0, len(names))
+	for name := range names {
+		requested = append(requested, name)
+	}
+	sort.Strings(requested)
+
+	existing, err := c.Filter(projectID, ProbeListOptions{Names: requested})
+	if err != nil {
+		return "", err
+	}
+	for _, p := range existing {
+		delete(names, p.Name)
+	}
+	if len(names) > 0 {
+		var missing []string
+		for name := range names {
+			missing = append(missing, name)
+		}
+		sort.Strings(missing)
+		return "", fmt.Errorf("probes not found in project %s: %s", projectID, strings.Join(missing, ", "))
+	}
+
+	specs := map[manifest.ProbeRef]string{}
+	resolved := make([]manifest.ProbeAttachment, len(attachments))
+	for i, a := range attachments {
+		key := manifest.ProbeRef{Name: a.Probe, Mode: string(a.Mode)}
+		if _, ok := specs[key]; !ok {
+			spec, err := c.GetProbeYAML(projectID, a.Probe, a.Mode)
+			if err != nil {
+				return "", err
+			}
+			specs[key] = spec.Raw
+		}
+		a.Spec = specs[key]
+		resolved[i] = a
+	}
+
+	return manifest.AttachProbes(experimentManifest, resolved...)
+}
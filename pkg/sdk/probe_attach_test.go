@@ -0,0 +1,50 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/probe"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/manifest"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const attachManifest = `{
+	"apiVersion": "argoproj.io/v1alpha1",
+	"kind": "Workflow",
+	"metadata": {"name": "checkout-chaos"},
+	"spec": {
+		"templates": [{
+			"name": "pod-delete",
+			"inputs": {"artifacts": [{"name": "pod-delete", "raw": {"data": "kind: ChaosEngine\nmetadata:\n  generateName: pod-delete\nspec:\n  experiments:\n    - name: pod-delete\n"}}]}
+		}]
+	}
+}`
+
+func TestProbeAttachToManifest(t *testing.T) {
+	checkout, err := probe.NewHTTPProbe("checkout-http", "http://checkout").Get("==", "200").Build()
+	require.NoError(t, err)
+	store := newProbeStore(t, checkout)
+	client := store.client
+
+	out, err := client.AttachToManifest("project", attachManifest,
+		manifest.ProbeAttachment{Fault: "pod-delete", Probe: "checkout-http", Mode: models.ModeSot})
+	require.NoError(t, err)
+	faults, err := manifest.Faults(out)
+	require.NoError(t, err)
+	assert.Equal(t, []manifest.ProbeRef{{Name: "checkout-http", Mode: "SOT"}}, faults[0].ProbeRefs)
+	assert.Contains(t, out, `probe:\n      - name: checkout-http\n        type: httpProbe\n        mode: SOT\n`)
+	var yamlRequests []interface{}
+	for _, call := range store.calls {
+		if call.operation() == "getProbeYAML" {
+			yamlRequests = append(yamlRequests, call.Variables["request"])
+		}
+	}
+	assert.Equal(t, []interface{}{map[string]interface{}{"probeName": "checkout-http", "mode": "SOT"}}, yamlRequests)
+
+	_, err = client.AttachToManifest("project", attachManifest,
+		manifest.ProbeAttachment{Fault: "pod-delete", Probe: "checkout-http", Mode: models.ModeSot},
+		manifest.ProbeAttachment{Fault: "pod-delete", Probe: "latency", Mode: models.ModeContinuous})
+	assert.EqualError(t, err, "probes not found in project project: latency")
+}
@@ -0,0 +1,200 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/probe"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/manifest"
+	"gopkg.in/yaml.v2"
+)
+
+// Version and kind of probe definition files
+const (
+	ProbeFileAPIVersion = "litmuschaos.io/v1"
+	ProbeFileKind       = "ProbeList"
+)
+
+// ProbeFile is the schema of probe definition files, which keep probes outside
+// of ChaosCenter, e.g. in git. Probes use the fields of probe.ProbeRequest:
+//
+//	apiVersion: litmuschaos.io/v1
+//	kind: ProbeList
+//	probes:
+//	  - name: checkout-up
+//	    type: httpProbe
+//	    infrastructureType: Kubernetes
+//	    kubernetesHTTPProperties:
+//	      probeTimeout: 5s
+//	      interval: 2s
+//	      url: http://checkout.shop.svc
+//	      method:
+//	        get:
+//	          criteria: "=="
+//	          responseCode: "200"
+type ProbeFile struct {
+	APIVersion string               `json:"apiVersion"`
+	Kind       string               `json:"kind"`
+	Probes     []probe.ProbeRequest `json:"probes"`
+}
+
+// ParseProbeFile decodes a YAML or JSON probe definition file. Unknown fields
+// are rejected, and every probe must be valid and uniquely named.
+func ParseProbeFile(data []byte) ([]probe.ProbeRequest, error) {
+	var file ProbeFile
+	if err := manifest.UnmarshalStrict(data, &file); err != nil {
+		return nil, fmt.Errorf("invalid probe file: %w", err)
+	}
+	if file.APIVersion != ProbeFileAPIVersion || file.Kind != ProbeFileKind {
+		return nil, fmt.Errorf("invalid probe file: expected apiVersion %s and kind %s, got %q and %q",
+			ProbeFileAPIVersion, ProbeFileKind, file.APIVersion, file.Kind)
+	}
+
+	names := map[string]bool{}
+	for i, p := range file.Probes {
+		if p.Name == "" {
+			return nil, fmt.Errorf("probe %d: name is required", i)
+		}
+		if names[p.Name] {
+			return nil, fmt.Errorf("duplicate probe %q", p.Name)
+		}
+		names[p.Name] = true
+	}
+	for _, p := range file.Probes {
+		if err := p.Validate(); err != nil {
+			return nil, fmt.Errorf("probe %q: %w", p.Name, err)
+		}
+	}
+	return file.Probes, nil
+}
+
+// MarshalProbeFile encodes probes as a YAML probe definition file. Keys are
+// sorted so that exporting the same probes always gives the same file.
+func MarshalProbeFile(probes []probe.ProbeRequest) ([]byte, error) {
+	if probes == nil {
+		probes = []probe.ProbeRequest{}
+	}
+	raw, err := json.Marshal(ProbeFile{APIVersion: ProbeFileAPIVersion, Kind: ProbeFileKind, Probes: probes})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal probes: %v", err)
+	}
+
+	var doc interface{}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("failed to marshal probes: %v", err)
+	}
+	return yaml.Marshal(doc)
+}
+
+// ProbeApplyOptions configures ApplyFile
+type ProbeApplyOptions struct {
+	// DryRun computes the changes without creating or updating any probe
+	DryRun bool
+}
+
+// ApplyFile reads a probe definition file, see ProbeFile, and reconciles the
+// project with it: probes whose name is still unique in the project are
+// created, existing probes are updated when a field set in the file differs.
+// Probes missing from the file are left untouched. The changes are returned in
+// the order of the file, up to the first failure.
+func (c *probeClient) ApplyFile(projectID string, path string, options ProbeApplyOptions) ([]Change, error) {
+	if c.credentials.Endpoint == "" {
+		return nil, fmt.Errorf("endpoint not set in credentials")
+	}
+
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID cannot be empty")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read probe file: %w", err)
+	}
+
+	requests, err := ParseProbeFile(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []Change
+	for _, request := range requests {
+		change, err := c.applyProbe(projectID, request, options)
+		if err != nil {
+			return changes, fmt.Errorf("failed to apply probe %s: %w", request.Name, err)
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+func (c *probeClient) applyProbe(projectID string, request probe.ProbeRequest, options ProbeApplyOptions) (Change, error) {
+	change := Change{Kind: KindProbe, Name: request.Name}
+
+	unique, err := probe.ValidateUniqueProbe(projectID, request.Name, c.credentials)
+	if err != nil {
+		return change, err
+	}
+
+	if unique {
+		change.Action = ActionCreate
+		if !options.DryRun {
+			_, err = c.Create(request, projectID)
+		}
+		return change, err
+	}
+
+	current, err := c.Get(projectID, request.Name)
+	if err != nil {
+		return change, err
+	}
+	if change.Fields, err = probe.Diff(request, probe.RequestFromModel(current)); err != nil {
+		return change, err
+	}
+
+	change.Action = ActionNoOp
+	if len(change.Fields) > 0 {
+		change.Action = ActionUpdate
+		if !options.DryRun {
+			err = c.Update(projectID, request)
+		}
+	}
+	return change, err
+}
+
+// Export renders the named probes, or every probe of the project when no name
+// is given, as a YAML probe definition file which ApplyFile accepts
+func (c *probeClient) Export(projectID string, names []string) ([]byte, error) {
+	var requests []probe.ProbeRequest
+	found := map[string]bool{}
+	for p, err := range c.Iterate(projectID, ProbeListOptions{Names: names}) {
+		if err != nil {
+			return nil, err
+		}
+		found[p.Name] = true
+		requests = append(requests, probe.RequestFromModel(p))
+	}
+
+	for _, name := range names {
+		if !found[name] {
+			return nil, fmt.Errorf("probe %s not found", name)
+		}
+	}
+
+	return MarshalProbeFile(requests)
+}
@@ -0,0 +1,153 @@
+package sdk
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/probe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeProbeFile(t *testing.T, probes ...probe.ProbeRequest) string {
+	data, err := MarshalProbeFile(probes)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "probes.yaml")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+func TestProbeApplyFile(t *testing.T) {
+	unchanged, err := probe.NewCMDProbe("disk", "df").Comparator(probe.ComparatorTypeInt, "<", "90").Build()
+	require.NoError(t, err)
+	current, err := probe.NewHTTPProbe("checkout", "http://checkout").Get("==", "200").Build()
+	require.NoError(t, err)
+	store := newProbeStore(t, unchanged, current)
+	client := store.client
+
+	changed, err := probe.NewHTTPProbe("checkout", "http://checkout:8080").Get("==", "200").Build()
+	require.NoError(t, err)
+	created, err := probe.NewK8SProbe("pods", "v1", "pods", probe.K8SOperationPresent).Namespace("shop").Build()
+	require.NoError(t, err)
+	path := writeProbeFile(t, unchanged, changed, created)
+
+	changes, err := client.ApplyFile("project", path, ProbeApplyOptions{DryRun: true})
+	require.NoError(t, err)
+	assert.Equal(t, []Change{
+		{Kind: KindProbe, Name: "disk", Action: ActionNoOp},
+		{Kind: KindProbe, Name: "checkout", Action: ActionUpdate, Fields: []string{"kubernetesHTTPProperties.url"}},
+		{Kind: KindProbe, Name: "pods", Action: ActionCreate},
+	}, changes)
+	assert.Empty(t, store.mutations)
+	assert.Equal(t, "~ probe checkout (kubernetesHTTPProperties.url)", changes[1].String())
+
+	_, err = client.ApplyFile("project", path, ProbeApplyOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"update checkout", "add pods"}, store.mutations)
+
+	// Applying again changes nothing
+	changes, err = client.ApplyFile("project", path, ProbeApplyOptions{})
+	require.NoError(t, err)
+	for _, change := range changes {
+		assert.Equal(t, ActionNoOp, change.Action, change.Name)
+	}
+}
+
+func TestProbeExport(t *testing.T) {
+	disk, err := probe.NewCMDProbe("disk", "df").Comparator(probe.ComparatorTypeInt, "<", "90").Build()
+	require.NoError(t, err)
+	pods, err := probe.NewK8SProbe("pods", "v1", "pods", probe.K8SOperationPresent).Build()
+	require.NoError(t, err)
+	client := newProbeStore(t, disk, pods).client
+
+	data, err := client.Export("project", []string{"pods"})
+	require.NoError(t, err)
+	exported, err := ParseProbeFile(data)
+	require.NoError(t, err)
+	assert.Equal(t, []probe.ProbeRequest{pods}, exported)
+
+	data, err = client.Export("project", nil)
+	require.NoError(t, err)
+	exported, err = ParseProbeFile(data)
+	require.NoError(t, err)
+	assert.Equal(t, []probe.ProbeRequest{disk, pods}, exported)
+
+	_, err = client.Export("project", []string{"pods", "missing"})
+	assert.EqualError(t, err, "probe missing not found")
+}
+
+const probeFile = `
+apiVersion: litmuschaos.io/v1
+kind: ProbeList
+probes:
+  - name: checkout-up
+    type: httpProbe
+    infrastructureType: Kubernetes
+    tags: [shop]
+    kubernetesHTTPProperties:
+      probeTimeout: 5s
+      interval: 2s
+      attempt: 1
+      url: http://checkout.shop.svc
+      method:
+        get:
+          criteria: "=="
+          responseCode: "200"
+`
+
+func TestParseProbeFile(t *testing.T) {
+	probes, err := ParseProbeFile([]byte(probeFile))
+	require.NoError(t, err)
+	require.Len(t, probes, 1)
+	assert.Equal(t, "checkout-up", probes[0].Name)
+	assert.Equal(t, []string{"shop"}, probes[0].Tags)
+	assert.Equal(t, 1, *probes[0].KubernetesHTTPProperties.Attempt)
+	assert.Equal(t, &probe.GetMethod{Criteria: "==", ResponseCode: "200"}, probes[0].KubernetesHTTPProperties.Method.Get)
+
+	// Exporting and parsing again gives the same probes and the same file
+	data, err := MarshalProbeFile(probes)
+	require.NoError(t, err)
+	again, err := ParseProbeFile(data)
+	require.NoError(t, err)
+	assert.Equal(t, probes, again)
+	twice, err := MarshalProbeFile(again)
+	require.NoError(t, err)
+	assert.Equal(t, string(data), string(twice))
+}
+
+func TestParseProbeFileErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		err  string
+	}{
+		{
+			name: "unknown kind",
+			file: "apiVersion: litmuschaos.io/v1\nkind: Probe\nprobes: []\n",
+			err:  "expected apiVersion litmuschaos.io/v1 and kind ProbeList",
+		},
+		{
+			name: "unknown field",
+			file: "apiVersion: litmuschaos.io/v1\nkind: ProbeList\nprobes:\n  - name: p\n    timeout: 5s\n",
+			err:  `unknown field "timeout"`,
+		},
+		{
+			name: "duplicate name",
+			file: "apiVersion: litmuschaos.io/v1\nkind: ProbeList\nprobes:\n  - name: p\n  - name: p\n",
+			err:  `duplicate probe "p"`,
+		},
+		{
+			name: "invalid probe",
+			file: "apiVersion: litmuschaos.io/v1\nkind: ProbeList\nprobes:\n  - name: p\n    type: httpProbe\n",
+			err:  `probe "p": no probe properties provided`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseProbeFile([]byte(tt.file))
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
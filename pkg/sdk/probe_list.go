@@ -0,0 +1,155 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"fmt"
+	"iter"
+	"strconv"
+	"time"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/probe"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+// probePageSize is the number of probes whose properties are fetched at once by Iterate
+const probePageSize = 50
+
+// ProbeListOptions narrows down a probe listing.
+// All set fields must match; empty options match every probe.
+type ProbeListOptions struct {
+	// Names restricts the listing to the probes with these exact names
+	Names []string
+
+	// Search is matched by the server against the probe names
+	Search string
+
+	// Types restricts the listing to the given probe types
+	Types []models.ProbeType
+
+	// InfrastructureType restricts the listing to probes for the given infrastructure
+	InfrastructureType models.InfrastructureType
+
+	// From and To bound the last update of the probes, a zero From means the
+	// beginning of time and a zero To means now
+	From time.Time
+	To   time.Time
+
+	// PageSize is the number of probes whose properties Iterate fetches per request, defaults to 50
+	PageSize int
+}
+
+// filter converts the options into the arguments of the listProbes query
+func (o ProbeListOptions) filter() (*models.InfrastructureType, models.ProbeFilterInput, error) {
+	var filter models.ProbeFilterInput
+	if o.Search != "" {
+		search := o.Search
+		filter.Name = &search
+	}
+
+	for i := range o.Types {
+		if !o.Types[i].IsValid() {
+			return nil, filter, fmt.Errorf("invalid probe type %q", o.Types[i])
+		}
+		filter.Type = append(filter.Type, &o.Types[i])
+	}
+
+	if !o.From.IsZero() || !o.To.IsZero() {
+		if !o.To.IsZero() && o.To.Before(o.From) {
+			return nil, filter, fmt.Errorf("invalid date range: %s is before %s", o.To.Format(time.RFC3339), o.From.Format(time.RFC3339))
+		}
+		filter.DateRange = &models.DateRange{StartDate: "0"}
+		if !o.From.IsZero() {
+			filter.DateRange.StartDate = strconv.FormatInt(o.From.UnixMilli(), 10)
+		}
+		if !o.To.IsZero() {
+			end := strconv.FormatInt(o.To.UnixMilli(), 10)
+			filter.DateRange.EndDate = &end
+		}
+	}
+
+	if o.InfrastructureType == "" {
+		return nil, filter, nil
+	}
+	if !o.InfrastructureType.IsValid() {
+		return nil, filter, fmt.Errorf("invalid infrastructure type %q", o.InfrastructureType)
+	}
+	infrastructureType := o.InfrastructureType
+	return &infrastructureType, filter, nil
+}
+
+// Filter lists the probes matching the options. Like List, only the name,
+// description, type, tags and timestamps of the probes are returned; use Get
+// or Iterate for their properties.
+func (c *probeClient) Filter(projectID string, options ProbeListOptions) ([]models.Probe, error) {
+	if c.credentials.Endpoint == "" {
+		return nil, fmt.Errorf("endpoint not set in credentials")
+	}
+
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID cannot be empty")
+	}
+
+	infrastructureType, filter, err := options.filter()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := probe.ListProbes(projectID, infrastructureType, options.Names, filter, c.credentials)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list probes: %w", err)
+	}
+
+	return response.Probes, nil
+}
+
+// Iterate yields every probe matching the options along with its properties.
+// The matching probes are listed once, then their properties are fetched a
+// page at a time as the loop advances, so breaking early saves the remaining
+// requests. An error ends the iteration.
+func (c *probeClient) Iterate(projectID string, options ProbeListOptions) iter.Seq2[models.Probe, error] {
+	return func(yield func(models.Probe, error) bool) {
+		matching, err := c.Filter(projectID, options)
+		if err != nil {
+			yield(models.Probe{}, err)
+			return
+		}
+
+		size := options.PageSize
+		if size <= 0 {
+			size = probePageSize
+		}
+
+		for start := 0; start < len(matching); start += size {
+			end := min(start+size, len(matching))
+			names := make([]string, 0, end-start)
+			for _, p := range matching[start:end] {
+				names = append(names, p.Name)
+			}
+
+			response, err := probe.ListProbeDetails(projectID, names, c.credentials)
+			if err != nil {
+				yield(models.Probe{}, fmt.Errorf("failed to list probes: %w", err))
+				return
+			}
+			for _, p := range response.Probes {
+				if !yield(p, nil) {
+					return
+				}
+			}
+		}
+	}
+}
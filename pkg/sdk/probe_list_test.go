@@ -0,0 +1,95 @@
+package sdk
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/probe"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/types"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cmdProbes builds a command probe for each name
+func cmdProbes(t *testing.T, names ...string) []probe.ProbeRequest {
+	var probes []probe.ProbeRequest
+	for _, name := range names {
+		p, err := probe.NewCMDProbe(name, "echo "+name).Comparator(probe.ComparatorTypeString, "equal", name).Build()
+		require.NoError(t, err)
+		probes = append(probes, p)
+	}
+	return probes
+}
+
+func TestProbeFilter(t *testing.T) {
+	store := newProbeStore(t, cmdProbes(t, "a", "b")...)
+	client := store.client
+
+	from := time.UnixMilli(1000)
+	probes, err := client.Filter("project", ProbeListOptions{
+		Names:              []string{"a"},
+		Search:             "a",
+		Types:              []models.ProbeType{models.ProbeTypeCmdProbe, models.ProbeTypeHTTPProbe},
+		InfrastructureType: models.InfrastructureTypeKubernetes,
+		From:               from,
+	})
+	require.NoError(t, err)
+	require.Len(t, probes, 1)
+	assert.Equal(t, "a", probes[0].Name)
+
+	require.Len(t, store.calls, 1)
+	variables := store.calls[0].Variables
+	assert.Equal(t, "project", variables["projectID"])
+	assert.Equal(t, "Kubernetes", variables["infrastructureType"])
+	assert.Equal(t, []interface{}{"a"}, variables["probeNames"])
+	assert.Equal(t, map[string]interface{}{
+		"name":      "a",
+		"type":      []interface{}{"cmdProbe", "httpProbe"},
+		"dateRange": map[string]interface{}{"startDate": "1000"},
+	}, variables["filter"])
+}
+
+func TestProbeFilterUntil(t *testing.T) {
+	_, filter, err := ProbeListOptions{To: time.UnixMilli(5000)}.filter()
+	require.NoError(t, err)
+	end := "5000"
+	assert.Equal(t, &models.DateRange{StartDate: "0", EndDate: &end}, filter.DateRange)
+}
+
+func TestProbeFilterInvalidOptions(t *testing.T) {
+	client := &probeClient{credentials: types.Credentials{Endpoint: "http://unused"}}
+
+	_, err := client.Filter("project", ProbeListOptions{Types: []models.ProbeType{"tcpProbe"}})
+	assert.ErrorContains(t, err, `invalid probe type "tcpProbe"`)
+
+	_, err = client.Filter("project", ProbeListOptions{From: time.UnixMilli(2000), To: time.UnixMilli(1000)})
+	assert.ErrorContains(t, err, "invalid date range")
+}
+
+func TestProbeIterate(t *testing.T) {
+	var names []string
+	for i := 0; i < 5; i++ {
+		names = append(names, fmt.Sprintf("probe-%d", i))
+	}
+	store := newProbeStore(t, cmdProbes(t, names...)...)
+	client := store.client
+
+	var seen []string
+	for p, err := range client.Iterate("project", ProbeListOptions{PageSize: 2}) {
+		require.NoError(t, err)
+		require.NotNil(t, p.KubernetesCMDProperties)
+		seen = append(seen, p.Name)
+	}
+	assert.Equal(t, names, seen)
+	assert.Equal(t, []string{"ListProbes", "ListProbeDetails", "ListProbeDetails", "ListProbeDetails"}, store.operations(),
+		"one listing and three pages of details")
+
+	// Breaking early skips the remaining pages
+	store.calls = nil
+	for range client.Iterate("project", ProbeListOptions{PageSize: 2}) {
+		break
+	}
+	assert.Len(t, store.calls, 2)
+}
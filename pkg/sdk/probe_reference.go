@@ -0,0 +1,268 @@
+/*
+Copyright © 2025 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/probe"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/manifest"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+// ProbeReference is a fault of an experiment which runs a probe in a given mode
+type ProbeReference struct {
+	ExperimentID   string
+	ExperimentName string
+	FaultName      string
+	Mode           models.Mode
+
+	// Verdicts of the recent executions of the probe in this fault, in the order
+	// returned by ChaosCenter. Empty for faults which never ran the probe.
+	Verdicts []models.ProbeVerdict
+}
+
+// ProbeReferences is the blast radius of a probe: the faults using it and how it fared in them
+type ProbeReferences struct {
+	Name string
+
+	// TotalRuns is the number of experiment runs which executed the probe
+	TotalRuns int
+
+	References []ProbeReference
+}
+
+// Experiments returns the sorted names of the experiments referencing the probe
+func (r ProbeReferences) Experiments() []string {
+	seen := map[string]bool{}
+	var names []string
+	for _, ref := range r.References {
+		if !seen[ref.ExperimentName] {
+			seen[ref.ExperimentName] = true
+			names = append(names, ref.ExperimentName)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ProbeDeleteOptions configures DeleteWithOptions
+type ProbeDeleteOptions struct {
+	// RefuseReferenced fails the deletion with a *ProbeInUseError when the
+	// saved manifest of an experiment attaches the probe
+	RefuseReferenced bool
+}
+
+// ProbeInUseError is returned when deleting a probe which experiments still reference
+type ProbeInUseError struct {
+	References ProbeReferences
+}
+
+func (e *ProbeInUseError) Error() string {
+	return fmt.Sprintf("probe %s is referenced by %d faults in experiments %s",
+		e.References.Name, len(e.References.References), strings.Join(e.References.Experiments(), ", "))
+}
+
+// References retrieves the experiment faults which reference a probe, with the
+// mode of the probe in each fault and its recent verdicts
+func (c *probeClient) References(projectID string, id string) (ProbeReferences, error) {
+	if c.credentials.Endpoint == "" {
+		return ProbeReferences{}, fmt.Errorf("endpoint not set in credentials")
+	}
+
+	if projectID == "" {
+		return ProbeReferences{}, fmt.Errorf("project ID cannot be empty")
+	}
+
+	if id == "" {
+		return ProbeReferences{}, fmt.Errorf("probe ID cannot be empty")
+	}
+
+	response, err := probe.GetProbeReference(projectID, id, c.credentials)
+	if err != nil {
+		return ProbeReferences{}, fmt.Errorf("failed to get probe references: %w", err)
+	}
+
+	return groupReferences(response.GetProbeReference), nil
+}
+
+// groupReferences flattens the execution history of a probe into one reference
+// per experiment, fault and mode
+func groupReferences(response models.GetProbeReferenceResponse) ProbeReferences {
+	references := ProbeReferences{Name: response.Name, TotalRuns: response.TotalRuns}
+	index := map[string]int{}
+
+	for _, recent := range response.RecentExecutions {
+		if recent == nil {
+			continue
+		}
+		for _, execution := range recent.ExecutionHistory {
+			if execution == nil {
+				continue
+			}
+
+			ref := ProbeReference{FaultName: execution.FaultName, Mode: execution.Mode}
+			if ref.FaultName == "" {
+				ref.FaultName = recent.FaultName
+			}
+			if ref.Mode == "" {
+				ref.Mode = recent.Mode
+			}
+			if experiment := execution.ExecutedByExperiment; experiment != nil {
+				ref.ExperimentID = experiment.ExperimentID
+				ref.ExperimentName = experiment.ExperimentName
+			}
+
+			key := referenceKey(ref)
+			i, ok := index[key]
+			if !ok {
+				i = len(references.References)
+				index[key] = i
+				references.References = append(references.References, ref)
+			}
+			if execution.Status != nil {
+				references.References[i].Verdicts = append(references.References[i].Verdicts, execution.Status.Verdict)
+			}
+		}
+	}
+
+	return references
+}
+
+// ListInRun retrieves the probes attached to a fault of an experiment run, with their mode and verdict
+func (c *probeClient) ListInRun(projectID string, experimentRunID string, faultName string) ([]*models.GetProbesInExperimentRunResponse, error) {
+	if c.credentials.Endpoint == "" {
+		return nil, fmt.Errorf("endpoint not set in credentials")
+	}
+
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID cannot be empty")
+	}
+
+	if experimentRunID == "" {
+		return nil, fmt.Errorf("experiment run ID cannot be empty")
+	}
+
+	if faultName == "" {
+		return nil, fmt.Errorf("fault name cannot be empty")
+	}
+
+	response, err := probe.GetProbesInExperimentRun(projectID, experimentRunID, faultName, c.credentials)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get probes of experiment run: %w", err)
+	}
+
+	return response.Probes, nil
+}
+
+// DeleteWithOptions removes a probe. With RefuseReferenced, a *ProbeInUseError
+// is returned if the manifest of a saved experiment attaches the probe, whether
+// or not it ran. The execution history of the probe only adds verdicts, since
+// it also covers experiments which were deleted or from which the probe was detached.
+func (c *probeClient) DeleteWithOptions(projectID string, id string, options ProbeDeleteOptions) error {
+	if options.RefuseReferenced {
+		executed, err := c.References(projectID, id)
+		if err != nil {
+			return err
+		}
+
+		saved, err := c.savedReferences(projectID, id)
+		if err != nil {
+			return err
+		}
+
+		references := ProbeReferences{
+			Name:       executed.Name,
+			TotalRuns:  executed.TotalRuns,
+			References: withVerdicts(saved, executed.References),
+		}
+		if references.Name == "" {
+			references.Name = id
+		}
+		if len(references.References) > 0 {
+			return &ProbeInUseError{References: references}
+		}
+	}
+
+	return c.Delete(projectID, id)
+}
+
+// savedReferences finds the faults of saved experiments whose manifest attaches
+// the probe, including experiments which never ran. Manifests which cannot be
+// read are skipped.
+func (c *probeClient) savedReferences(projectID string, name string) ([]ProbeReference, error) {
+	experiments := &experimentClient{credentials: c.credentials}
+	experiments.credentials.ProjectID = projectID
+
+	list, err := experiments.listAllExperiments(models.ListExperimentRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list experiments: %w", err)
+	}
+
+	var references []ProbeReference
+	for _, exp := range list {
+		if exp == nil || exp.IsRemoved {
+			continue
+		}
+		faults, err := manifest.Faults(exp.ExperimentManifest)
+		if err != nil {
+			continue
+		}
+		for _, fault := range faults {
+			for _, ref := range fault.ProbeRefs {
+				if ref.Name == name {
+					references = append(references, ProbeReference{
+						ExperimentID:   exp.ExperimentID,
+						ExperimentName: exp.Name,
+						FaultName:      fault.Name,
+						Mode:           models.Mode(ref.Mode),
+					})
+				}
+			}
+		}
+	}
+	return references, nil
+}
+
+// withVerdicts deduplicates the saved references and adds the verdicts of the
+// executions by the same fault and mode
+func withVerdicts(saved []ProbeReference, executed []ProbeReference) []ProbeReference {
+	verdicts := make(map[string][]models.ProbeVerdict, len(executed))
+	for _, ref := range executed {
+		key := referenceKey(ref)
+		verdicts[key] = append(verdicts[key], ref.Verdicts...)
+	}
+
+	var references []ProbeReference
+	seen := make(map[string]bool, len(saved))
+	for _, ref := range saved {
+		key := referenceKey(ref)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		ref.Verdicts = verdicts[key]
+		references = append(references, ref)
+	}
+	return references
+}
+
+// referenceKey identifies the use of a probe by a fault of an experiment in a given mode
+func referenceKey(ref ProbeReference) string {
+	return strings.Join([]string{ref.ExperimentID, ref.FaultName, string(ref.Mode)}, "/")
+}
@@ -0,0 +1,126 @@
+package sdk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/manifest"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const referenceResponse = `{"data":{"getProbeReference":{
+	"projectID":"project","name":"checkout-up","totalRuns":3,
+	"recentExecutions":[{"faultName":"pod-delete","mode":"SOT","executionHistory":[
+		{"mode":"SOT","faultName":"pod-delete","status":{"verdict":"Passed"},"executedByExperiment":{"experimentID":"e1","experimentName":"shop"}},
+		{"mode":"SOT","faultName":"pod-delete","status":{"verdict":"Failed"},"executedByExperiment":{"experimentID":"e1","experimentName":"shop"}},
+		{"mode":"SOT","faultName":"pod-delete","status":{"verdict":"Passed"},"executedByExperiment":{"experimentID":"e2","experimentName":"cart"}}
+	]},{"faultName":"network-loss","mode":"Continuous","executionHistory":[
+		{"status":{"verdict":"Awaited"},"executedByExperiment":{"experimentID":"e1","experimentName":"shop"}}
+	]}]
+}}}`
+
+func TestProbeReferences(t *testing.T) {
+	store := newProbeStore(t)
+	store.references = referenceResponse
+	client := store.client
+
+	references, err := client.References("project", "checkout-up")
+	require.NoError(t, err)
+
+	assert.Equal(t, "checkout-up", references.Name)
+	assert.Equal(t, 3, references.TotalRuns)
+	assert.Equal(t, []ProbeReference{
+		{ExperimentID: "e1", ExperimentName: "shop", FaultName: "pod-delete", Mode: models.ModeSot, Verdicts: []models.ProbeVerdict{models.ProbeVerdictPassed, models.ProbeVerdictFailed}},
+		{ExperimentID: "e2", ExperimentName: "cart", FaultName: "pod-delete", Mode: models.ModeSot, Verdicts: []models.ProbeVerdict{models.ProbeVerdictPassed}},
+		{ExperimentID: "e1", ExperimentName: "shop", FaultName: "network-loss", Mode: models.ModeContinuous, Verdicts: []models.ProbeVerdict{models.ProbeVerdictAwaited}},
+	}, references.References)
+	assert.Equal(t, []string{"cart", "shop"}, references.Experiments())
+}
+
+func TestProbeDeleteWithOptions(t *testing.T) {
+	attached, err := manifest.AttachProbes(attachManifest, manifest.ProbeAttachment{Fault: "pod-delete", Probe: "checkout-up", Mode: models.ModeSot})
+	require.NoError(t, err)
+
+	// The network-loss fault of shop ran the probe but no longer attaches it
+	store := newProbeStore(t)
+	store.references = referenceResponse
+	store.experiments = []*models.Experiment{
+		{ExperimentID: "e1", Name: "shop", ExperimentManifest: attached},
+		{ExperimentID: "e2", Name: "cart", ExperimentManifest: attached},
+	}
+	client := store.client
+
+	err = client.DeleteWithOptions("project", "checkout-up", ProbeDeleteOptions{RefuseReferenced: true})
+	var inUse *ProbeInUseError
+	require.True(t, errors.As(err, &inUse))
+	assert.EqualError(t, err, "probe checkout-up is referenced by 2 faults in experiments cart, shop")
+	assert.Equal(t, []ProbeReference{
+		{ExperimentID: "e1", ExperimentName: "shop", FaultName: "pod-delete", Mode: models.ModeSot, Verdicts: []models.ProbeVerdict{models.ProbeVerdictPassed, models.ProbeVerdictFailed}},
+		{ExperimentID: "e2", ExperimentName: "cart", FaultName: "pod-delete", Mode: models.ModeSot, Verdicts: []models.ProbeVerdict{models.ProbeVerdictPassed}},
+	}, inUse.References.References)
+	assert.Equal(t, []string{"getProbeReference", "listExperiment"}, store.operations())
+
+	require.NoError(t, client.DeleteWithOptions("project", "checkout-up", ProbeDeleteOptions{}))
+	assert.Equal(t, []string{"getProbeReference", "listExperiment", "deleteProbe"}, store.operations())
+	assert.Equal(t, []string{"delete checkout-up"}, store.mutations)
+}
+
+func TestProbeDeleteOnlyExecuted(t *testing.T) {
+	// cart was deleted and shop no longer attaches the probe, so only the
+	// execution history still names them
+	store := newProbeStore(t)
+	store.references = referenceResponse
+	store.experiments = []*models.Experiment{{ExperimentID: "e1", Name: "shop", ExperimentManifest: attachManifest}}
+	client := store.client
+
+	require.NoError(t, client.DeleteWithOptions("project", "checkout-up", ProbeDeleteOptions{RefuseReferenced: true}))
+	assert.Equal(t, []string{"getProbeReference", "listExperiment", "deleteProbe"}, store.operations())
+	assert.Equal(t, []string{"delete checkout-up"}, store.mutations)
+}
+
+func TestProbeDeleteUnreferenced(t *testing.T) {
+	store := newProbeStore(t)
+	client := store.client
+
+	require.NoError(t, client.DeleteWithOptions("project", "unused", ProbeDeleteOptions{RefuseReferenced: true}))
+	assert.Equal(t, []string{"getProbeReference", "listExperiment", "deleteProbe"}, store.operations())
+	assert.Equal(t, []string{"delete unused"}, store.mutations)
+}
+
+func TestProbeDeleteReferencedByUnrunExperiment(t *testing.T) {
+	attached, err := manifest.AttachProbes(attachManifest, manifest.ProbeAttachment{Fault: "pod-delete", Probe: "checkout-up", Mode: models.ModeSot})
+	require.NoError(t, err)
+
+	store := newProbeStore(t)
+	store.experiments = []*models.Experiment{
+		{ExperimentID: "e3", Name: "checkout", ExperimentManifest: attached},
+		{ExperimentID: "e4", Name: "removed", ExperimentManifest: attached, IsRemoved: true},
+		{ExperimentID: "e5", Name: "other", ExperimentManifest: attachManifest},
+	}
+	client := store.client
+
+	err = client.DeleteWithOptions("project", "checkout-up", ProbeDeleteOptions{RefuseReferenced: true})
+	var inUse *ProbeInUseError
+	require.True(t, errors.As(err, &inUse))
+	assert.Equal(t, []ProbeReference{{ExperimentID: "e3", ExperimentName: "checkout", FaultName: "pod-delete", Mode: models.ModeSot}}, inUse.References.References)
+	assert.EqualError(t, err, "probe checkout-up is referenced by 1 faults in experiments checkout")
+	assert.Equal(t, []string{"getProbeReference", "listExperiment"}, store.operations())
+}
+
+func TestWithVerdicts(t *testing.T) {
+	executed := []ProbeReference{
+		{ExperimentID: "e1", FaultName: "pod-delete", Mode: models.ModeSot, Verdicts: []models.ProbeVerdict{models.ProbeVerdictPassed}},
+		{ExperimentID: "e9", FaultName: "pod-delete", Mode: models.ModeSot, Verdicts: []models.ProbeVerdict{models.ProbeVerdictFailed}},
+	}
+	references := withVerdicts([]ProbeReference{
+		{ExperimentID: "e1", FaultName: "pod-delete", Mode: models.ModeSot},
+		{ExperimentID: "e1", FaultName: "pod-delete", Mode: models.ModeEot},
+		{ExperimentID: "e1", FaultName: "pod-delete", Mode: models.ModeEot},
+	}, executed)
+	assert.Equal(t, []ProbeReference{
+		executed[0],
+		{ExperimentID: "e1", FaultName: "pod-delete", Mode: models.ModeEot},
+	}, references)
+}
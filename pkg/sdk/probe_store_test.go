@@ -0,0 +1,155 @@
+package sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/litmuschaos/litmus-go-sdk/pkg/apis/probe"
+	"github.com/litmuschaos/litmus-go-sdk/pkg/types"
+	models "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/stretchr/testify/require"
+)
+
+type graphQLCall struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+// operation returns the name of the query or mutation called
+func (c graphQLCall) operation() string {
+	fields := strings.FieldsFunc(c.Query, func(r rune) bool {
+		return unicode.IsSpace(r) || r == '(' || r == '{'
+	})
+	if len(fields) < 2 {
+		return ""
+	}
+	return fields[1]
+}
+
+// decode unmarshals a variable of the call into v
+func (c graphQLCall) decode(t *testing.T, name string, v interface{}) {
+	data, err := json.Marshal(c.Variables[name])
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, v))
+}
+
+// probeStore is an in-memory ChaosCenter project serving the queries of the
+// probe client. It records every call and, separately, the mutations.
+type probeStore struct {
+	t      *testing.T
+	client *probeClient
+
+	probes map[string]models.Probe
+	order  []string
+
+	// experiments are the saved experiments returned by listExperiment
+	experiments []*models.Experiment
+
+	// references is the raw response to getProbeReference
+	references string
+
+	calls     []graphQLCall
+	mutations []string
+}
+
+func newProbeStore(t *testing.T, probes ...probe.ProbeRequest) *probeStore {
+	s := &probeStore{t: t, probes: map[string]models.Probe{}}
+	for _, p := range probes {
+		s.put(probe.ModelFromRequest(p))
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(s.serve))
+	t.Cleanup(server.Close)
+	s.client = &probeClient{credentials: types.Credentials{Endpoint: server.URL}}
+	return s
+}
+
+// put adds or replaces a probe, keeping the order in which probes were added
+func (s *probeStore) put(p models.Probe) {
+	if _, ok := s.probes[p.Name]; !ok {
+		s.order = append(s.order, p.Name)
+	}
+	s.probes[p.Name] = p
+}
+
+// operations returns the names of the operations called so far
+func (s *probeStore) operations() []string {
+	var operations []string
+	for _, call := range s.calls {
+		operations = append(operations, call.operation())
+	}
+	return operations
+}
+
+func (s *probeStore) serve(w http.ResponseWriter, r *http.Request) {
+	var call graphQLCall
+	require.NoError(s.t, json.NewDecoder(r.Body).Decode(&call))
+	s.calls = append(s.calls, call)
+	name, _ := call.Variables["probeName"].(string)
+
+	var data interface{}
+	switch operation := call.operation(); operation {
+	case "validateUniqueProbe":
+		_, exists := s.probes[name]
+		data = map[string]bool{operation: !exists}
+	case "getProbe":
+		data = map[string]models.Probe{operation: s.probes[name]}
+	case "getProbeYAML":
+		var request models.GetProbeYAMLRequest
+		call.decode(s.t, "request", &request)
+		p := s.probes[request.ProbeName]
+		data = map[string]string{operation: fmt.Sprintf(`{"name":%q,"type":%q,"mode":%q}`, p.Name, p.Type, request.Mode)}
+	case "addProbe":
+		var request probe.ProbeRequest
+		call.decode(s.t, "request", &request)
+		s.mutations = append(s.mutations, "add "+request.Name)
+		s.put(probe.ModelFromRequest(request))
+		data = map[string]models.Probe{operation: s.probes[request.Name]}
+	case "updateProbe":
+		var request probe.ProbeRequest
+		call.decode(s.t, "request", &request)
+		s.mutations = append(s.mutations, "update "+request.Name)
+		s.put(probe.ModelFromRequest(request))
+		data = map[string]string{operation: "probe updated"}
+	case "deleteProbe":
+		s.mutations = append(s.mutations, "delete "+name)
+		delete(s.probes, name)
+		data = map[string]bool{operation: true}
+	case "ListProbes", "ListProbeDetails":
+		var names []string
+		call.decode(s.t, "probeNames", &names)
+		if names == nil {
+			names = s.order
+		}
+		var list []models.Probe
+		for _, name := range names {
+			p, ok := s.probes[name]
+			if !ok {
+				continue
+			}
+			// The listing only selects the summary of probes, not their properties
+			if operation == "ListProbes" {
+				p = models.Probe{Name: p.Name, Description: p.Description, Type: p.Type, InfrastructureType: p.InfrastructureType, Tags: p.Tags}
+			}
+			list = append(list, p)
+		}
+		data = map[string][]models.Probe{"listProbes": list}
+	case "getProbeReference":
+		if s.references == "" {
+			data = map[string]models.GetProbeReferenceResponse{operation: {Name: name}}
+			break
+		}
+		_, _ = w.Write([]byte(s.references))
+		return
+	case "listExperiment":
+		data = map[string]models.ListExperimentResponse{operation: {TotalNoOfExperiments: len(s.experiments), Experiments: s.experiments}}
+	default:
+		s.t.Fatalf("unexpected query %s", call.Query)
+	}
+	require.NoError(s.t, json.NewEncoder(w).Encode(map[string]interface{}{"data": data}))
+}